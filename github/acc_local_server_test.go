@@ -0,0 +1,1310 @@
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/crypto/nacl/box"
+)
+
+// localGitHubServer is an in-memory fake of the parts of the GitHub REST and GraphQL APIs used by the acceptance
+// tests. It backs the local test mode, which runs acceptance tests without network access or a GitHub account.
+//
+// The server answers both under the GitHub Enterprise Server paths (/api/v3 and /api/graphql), which the provider
+// uses when base_url points at it, and under the github.com paths. Requests to endpoints it does not implement
+// fail with 501 Not Implemented, so unsupported tests fail loudly rather than pass against incomplete state.
+type localGitHubServer struct {
+	*httptest.Server
+
+	owner    string
+	username string
+
+	// secretPublicKey and secretPrivateKey form the sealed box key pair served for actions secrets. The plaintext
+	// of every stored secret is kept in secretValues, keyed by the secret path, so tests can assert on it.
+	secretPublicKey  *[32]byte
+	secretPrivateKey *[32]byte
+
+	mu                sync.Mutex
+	lastID            int64
+	repositories      map[string]*localRepository
+	teams             map[int64]map[string]any
+	orgRulesets       map[int64]map[string]any
+	orgSecrets        map[string]map[string]any
+	branchProtections map[string]map[string]any
+	secretValues      map[string]string
+}
+
+type localRepository struct {
+	data                map[string]any
+	vulnerabilityAlerts bool
+	refs                map[string]string
+	labels              map[string]map[string]any
+	rulesets            map[int64]map[string]any
+	secrets             map[string]map[string]any
+	teams               map[int64]string
+}
+
+const localSecretKeyID = "local-key-id"
+
+var graphQLOperationRegexp = regexp.MustCompile(`^\s*(?:query|mutation)?[^{]*\{\s*(\w+)`)
+
+// newLocalGitHubServer starts a fake GitHub API for the organization owner, authenticated as username.
+func newLocalGitHubServer(owner, username string) (*localGitHubServer, error) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &localGitHubServer{
+		owner:             owner,
+		username:          username,
+		secretPublicKey:   publicKey,
+		secretPrivateKey:  privateKey,
+		lastID:            1000,
+		repositories:      make(map[string]*localRepository),
+		teams:             make(map[int64]map[string]any),
+		orgRulesets:       make(map[int64]map[string]any),
+		orgSecrets:        make(map[string]map[string]any),
+		branchProtections: make(map[string]map[string]any),
+		secretValues:      make(map[string]string),
+	}
+
+	mux := http.NewServeMux()
+	s.registerRoutes(mux)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.URL.Path = strings.TrimPrefix(r.URL.Path, "/api/v3")
+		if r.URL.Path == "/api/graphql" {
+			r.URL.Path = "/graphql"
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		mux.ServeHTTP(w, r)
+	}))
+
+	return s, nil
+}
+
+func (s *localGitHubServer) registerRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		localError(w, http.StatusNotImplemented, fmt.Sprintf("local GitHub server does not implement %s %s", r.Method, r.URL.Path))
+	})
+
+	mux.HandleFunc("GET /user", s.getUser)
+	mux.HandleFunc("GET /orgs/{org}", s.getOrganization)
+
+	mux.HandleFunc("POST /orgs/{org}/repos", s.createRepository)
+	mux.HandleFunc("POST /user/repos", s.createRepository)
+	mux.HandleFunc("GET /repos/{owner}/{repo}", s.withRepository(s.getRepository))
+	mux.HandleFunc("PATCH /repos/{owner}/{repo}", s.withRepository(s.editRepository))
+	mux.HandleFunc("DELETE /repos/{owner}/{repo}", s.withRepository(s.deleteRepository))
+	mux.HandleFunc("PUT /repos/{owner}/{repo}/topics", s.withRepository(s.replaceTopics))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/vulnerability-alerts", s.withRepository(s.getVulnerabilityAlerts))
+	mux.HandleFunc("PUT /repos/{owner}/{repo}/vulnerability-alerts", s.withRepository(s.setVulnerabilityAlerts(true)))
+	mux.HandleFunc("DELETE /repos/{owner}/{repo}/vulnerability-alerts", s.withRepository(s.setVulnerabilityAlerts(false)))
+
+	mux.HandleFunc("GET /repos/{owner}/{repo}/branches/{branch...}", s.withRepository(s.getBranch))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/git/ref/{ref...}", s.withRepository(s.getRef))
+	mux.HandleFunc("POST /repos/{owner}/{repo}/git/refs", s.withRepository(s.createRef))
+	mux.HandleFunc("PATCH /repos/{owner}/{repo}/git/refs/{ref...}", s.withRepository(s.updateRef))
+	mux.HandleFunc("DELETE /repos/{owner}/{repo}/git/refs/{ref...}", s.withRepository(s.deleteRef))
+
+	mux.HandleFunc("POST /repos/{owner}/{repo}/labels", s.withRepository(s.createLabel))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/labels/{name}", s.withRepository(s.getLabel))
+	mux.HandleFunc("PATCH /repos/{owner}/{repo}/labels/{name}", s.withRepository(s.editLabel))
+	mux.HandleFunc("DELETE /repos/{owner}/{repo}/labels/{name}", s.withRepository(s.deleteLabel))
+
+	mux.HandleFunc("POST /repos/{owner}/{repo}/rulesets", s.withRepository(s.createRepositoryRuleset))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/rulesets/{id}", s.withRepository(s.getRepositoryRuleset))
+	mux.HandleFunc("PUT /repos/{owner}/{repo}/rulesets/{id}", s.withRepository(s.updateRepositoryRuleset))
+	mux.HandleFunc("DELETE /repos/{owner}/{repo}/rulesets/{id}", s.withRepository(s.deleteRepositoryRuleset))
+	mux.HandleFunc("POST /orgs/{org}/rulesets", s.createOrganizationRuleset)
+	mux.HandleFunc("GET /orgs/{org}/rulesets/{id}", s.getOrganizationRuleset)
+	mux.HandleFunc("PUT /orgs/{org}/rulesets/{id}", s.updateOrganizationRuleset)
+	mux.HandleFunc("DELETE /orgs/{org}/rulesets/{id}", s.deleteOrganizationRuleset)
+
+	mux.HandleFunc("GET /repos/{owner}/{repo}/actions/secrets/public-key", s.withRepository(s.getSecretPublicKey))
+	mux.HandleFunc("GET /repos/{owner}/{repo}/actions/secrets/{name}", s.withRepository(s.getRepositorySecret))
+	mux.HandleFunc("PUT /repos/{owner}/{repo}/actions/secrets/{name}", s.withRepository(s.putRepositorySecret))
+	mux.HandleFunc("DELETE /repos/{owner}/{repo}/actions/secrets/{name}", s.withRepository(s.deleteRepositorySecret))
+	mux.HandleFunc("GET /orgs/{org}/actions/secrets/public-key", func(w http.ResponseWriter, r *http.Request) { s.getSecretPublicKey(w, r, nil) })
+	mux.HandleFunc("GET /orgs/{org}/actions/secrets/{name}", s.getOrganizationSecret)
+	mux.HandleFunc("PUT /orgs/{org}/actions/secrets/{name}", s.putOrganizationSecret)
+	mux.HandleFunc("DELETE /orgs/{org}/actions/secrets/{name}", s.deleteOrganizationSecret)
+	mux.HandleFunc("GET /orgs/{org}/actions/secrets/{name}/repositories", s.listOrganizationSecretRepositories)
+
+	mux.HandleFunc("POST /orgs/{org}/teams", s.createTeam)
+	mux.HandleFunc("GET /orgs/{org}/teams/{slug}", s.withTeamSlug(s.getTeam))
+	mux.HandleFunc("PATCH /orgs/{org}/teams/{slug}", s.withTeamSlug(s.editTeam))
+	mux.HandleFunc("GET /orgs/{org}/teams/{slug}/members", s.withTeamSlug(s.listTeamMembers))
+	mux.HandleFunc("GET /organizations/{org_id}/team/{id}", s.withTeamID(s.getTeam))
+	mux.HandleFunc("PATCH /organizations/{org_id}/team/{id}", s.withTeamID(s.editTeam))
+	mux.HandleFunc("DELETE /organizations/{org_id}/team/{id}", s.withTeamID(s.deleteTeam))
+	mux.HandleFunc("GET /organizations/{org_id}/team/{id}/repos/{owner}/{repo}", s.withTeamID(s.getTeamRepository))
+	mux.HandleFunc("PUT /organizations/{org_id}/team/{id}/repos/{owner}/{repo}", s.withTeamID(s.addTeamRepository))
+	mux.HandleFunc("DELETE /organizations/{org_id}/team/{id}/repos/{owner}/{repo}", s.withTeamID(s.removeTeamRepository))
+
+	mux.HandleFunc("POST /graphql", s.graphQL)
+}
+
+func (s *localGitHubServer) nextID() int64 {
+	s.lastID++
+	return s.lastID
+}
+
+func (s *localGitHubServer) organizationID() int64 {
+	return 1
+}
+
+func localTimestamp() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+func localWriteJSON(w http.ResponseWriter, status int, v any) {
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		panic(err)
+	}
+}
+
+func localError(w http.ResponseWriter, status int, message string) {
+	localWriteJSON(w, status, map[string]any{
+		"message":           message,
+		"documentation_url": "https://docs.github.com/rest",
+	})
+}
+
+func localReadJSON(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	body := make(map[string]any)
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		localError(w, http.StatusBadRequest, fmt.Sprintf("Problems parsing JSON: %s", err))
+		return nil, false
+	}
+	return body, true
+}
+
+// localMerge copies the non-null values of patch into data.
+func localMerge(data, patch map[string]any) {
+	for k, v := range patch {
+		if v != nil {
+			data[k] = v
+		}
+	}
+}
+
+func localInt64(v any) int64 {
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+	case int64:
+		return n
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
+
+func (s *localGitHubServer) getUser(w http.ResponseWriter, _ *http.Request) {
+	localWriteJSON(w, http.StatusOK, map[string]any{
+		"login":   s.username,
+		"id":      2,
+		"node_id": "U_2",
+		"type":    "User",
+	})
+}
+
+func (s *localGitHubServer) getOrganization(w http.ResponseWriter, r *http.Request) {
+	if r.PathValue("org") != s.owner {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	localWriteJSON(w, http.StatusOK, map[string]any{
+		"login":   s.owner,
+		"id":      s.organizationID(),
+		"node_id": "O_1",
+		"type":    "Organization",
+		"plan":    map[string]any{"name": "team"},
+	})
+}
+
+// Repositories
+
+func (s *localGitHubServer) withRepository(handler func(http.ResponseWriter, *http.Request, *localRepository)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		repo, ok := s.repositories[r.PathValue("repo")]
+		if !ok || r.PathValue("owner") != s.owner {
+			localError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		handler(w, r, repo)
+	}
+}
+
+func (s *localGitHubServer) createRepository(w http.ResponseWriter, r *http.Request) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	name, _ := body["name"].(string)
+	if name == "" {
+		localError(w, http.StatusUnprocessableEntity, "Repository creation failed: name is missing")
+		return
+	}
+	if _, exists := s.repositories[name]; exists {
+		localError(w, http.StatusUnprocessableEntity, "Repository creation failed: name already exists on this account")
+		return
+	}
+
+	id := s.nextID()
+	htmlURL := fmt.Sprintf("%s/%s/%s", s.URL, s.owner, name)
+	data := map[string]any{
+		"id":                          id,
+		"node_id":                     fmt.Sprintf("R_%d", id),
+		"name":                        name,
+		"full_name":                   s.owner + "/" + name,
+		"owner":                       map[string]any{"login": s.owner, "type": "Organization"},
+		"private":                     false,
+		"visibility":                  "public",
+		"description":                 "",
+		"homepage":                    "",
+		"default_branch":              "main",
+		"has_issues":                  true,
+		"has_projects":                true,
+		"has_wiki":                    true,
+		"has_downloads":               true,
+		"has_discussions":             false,
+		"is_template":                 false,
+		"archived":                    false,
+		"allow_merge_commit":          true,
+		"allow_squash_merge":          true,
+		"allow_rebase_merge":          true,
+		"allow_auto_merge":            false,
+		"allow_update_branch":         false,
+		"allow_forking":               true,
+		"delete_branch_on_merge":      false,
+		"web_commit_signoff_required": false,
+		"merge_commit_title":          "MERGE_MESSAGE",
+		"merge_commit_message":        "PR_TITLE",
+		"squash_merge_commit_title":   "COMMIT_OR_PR_TITLE",
+		"squash_merge_commit_message": "COMMIT_MESSAGES",
+		"topics":                      []any{},
+		"html_url":                    htmlURL,
+		"clone_url":                   htmlURL + ".git",
+		"git_url":                     strings.Replace(htmlURL, "http", "git", 1) + ".git",
+		"ssh_url":                     fmt.Sprintf("git@localhost:%s/%s.git", s.owner, name),
+		"svn_url":                     htmlURL,
+		"created_at":                  localTimestamp(),
+	}
+
+	autoInit, _ := body["auto_init"].(bool)
+	for _, k := range []string{"auto_init", "gitignore_template", "license_template", "team_id"} {
+		delete(body, k)
+	}
+	localMerge(data, body)
+	localSyncVisibility(data, body)
+
+	repo := &localRepository{
+		data:     data,
+		refs:     make(map[string]string),
+		labels:   make(map[string]map[string]any),
+		rulesets: make(map[int64]map[string]any),
+		secrets:  make(map[string]map[string]any),
+		teams:    make(map[int64]string),
+	}
+	if autoInit {
+		repo.refs["refs/heads/main"] = localCommitSHA(id)
+	}
+	s.repositories[name] = repo
+
+	localWriteJSON(w, http.StatusCreated, data)
+}
+
+// localSyncVisibility keeps the private and visibility fields of a repository consistent with a request body.
+func localSyncVisibility(data, body map[string]any) {
+	if visibility, ok := body["visibility"].(string); ok {
+		data["private"] = visibility != "public"
+	} else if private, ok := body["private"].(bool); ok {
+		if private {
+			data["visibility"] = "private"
+		} else {
+			data["visibility"] = "public"
+		}
+	}
+}
+
+func localCommitSHA(seed int64) string {
+	return fmt.Sprintf("%040x", seed)
+}
+
+func (s *localGitHubServer) getRepository(w http.ResponseWriter, _ *http.Request, repo *localRepository) {
+	localWriteJSON(w, http.StatusOK, repo.data)
+}
+
+func (s *localGitHubServer) editRepository(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	oldName := repo.data["name"].(string)
+	localMerge(repo.data, body)
+	localSyncVisibility(repo.data, body)
+
+	if newName := repo.data["name"].(string); newName != oldName {
+		delete(s.repositories, oldName)
+		s.repositories[newName] = repo
+		repo.data["full_name"] = s.owner + "/" + newName
+	}
+
+	localWriteJSON(w, http.StatusOK, repo.data)
+}
+
+func (s *localGitHubServer) deleteRepository(w http.ResponseWriter, _ *http.Request, repo *localRepository) {
+	delete(s.repositories, repo.data["name"].(string))
+	for id, rule := range s.branchProtections {
+		if rule["repositoryId"] == repo.data["node_id"] {
+			delete(s.branchProtections, id)
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *localGitHubServer) replaceTopics(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	names, _ := body["names"].([]any)
+	if names == nil {
+		names = []any{}
+	}
+	repo.data["topics"] = names
+
+	localWriteJSON(w, http.StatusOK, map[string]any{"names": names})
+}
+
+func (s *localGitHubServer) getVulnerabilityAlerts(w http.ResponseWriter, _ *http.Request, repo *localRepository) {
+	if !repo.vulnerabilityAlerts {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *localGitHubServer) setVulnerabilityAlerts(enabled bool) func(http.ResponseWriter, *http.Request, *localRepository) {
+	return func(w http.ResponseWriter, _ *http.Request, repo *localRepository) {
+		repo.vulnerabilityAlerts = enabled
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// Branches and references
+
+func (s *localGitHubServer) getBranch(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	branch := r.PathValue("branch")
+	sha, ok := repo.refs["refs/heads/"+branch]
+	if !ok {
+		localError(w, http.StatusNotFound, "Branch not found")
+		return
+	}
+
+	localWriteJSON(w, http.StatusOK, map[string]any{
+		"name":      branch,
+		"commit":    map[string]any{"sha": sha},
+		"protected": false,
+	})
+}
+
+func localRef(ref, sha string) map[string]any {
+	return map[string]any{
+		"ref":    ref,
+		"object": map[string]any{"sha": sha, "type": "commit"},
+	}
+}
+
+func (s *localGitHubServer) getRef(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	ref := "refs/" + r.PathValue("ref")
+	sha, ok := repo.refs[ref]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	localWriteJSON(w, http.StatusOK, localRef(ref, sha))
+}
+
+func (s *localGitHubServer) createRef(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	ref, _ := body["ref"].(string)
+	sha, _ := body["sha"].(string)
+	if _, exists := repo.refs[ref]; exists {
+		localError(w, http.StatusUnprocessableEntity, "Reference already exists")
+		return
+	}
+	repo.refs[ref] = sha
+
+	localWriteJSON(w, http.StatusCreated, localRef(ref, sha))
+}
+
+func (s *localGitHubServer) updateRef(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	ref := "refs/" + r.PathValue("ref")
+	if _, exists := repo.refs[ref]; !exists {
+		localError(w, http.StatusUnprocessableEntity, "Reference does not exist")
+		return
+	}
+	repo.refs[ref], _ = body["sha"].(string)
+
+	localWriteJSON(w, http.StatusOK, localRef(ref, repo.refs[ref]))
+}
+
+func (s *localGitHubServer) deleteRef(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	ref := "refs/" + r.PathValue("ref")
+	if _, exists := repo.refs[ref]; !exists {
+		localError(w, http.StatusUnprocessableEntity, "Reference does not exist")
+		return
+	}
+	delete(repo.refs, ref)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Labels
+
+func (s *localGitHubServer) createLabel(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	name, _ := body["name"].(string)
+	if _, exists := repo.labels[strings.ToLower(name)]; exists {
+		localError(w, http.StatusUnprocessableEntity, "Validation Failed")
+		return
+	}
+
+	id := s.nextID()
+	label := map[string]any{
+		"id":          id,
+		"node_id":     fmt.Sprintf("LA_%d", id),
+		"description": "",
+		"default":     false,
+	}
+	localMerge(label, body)
+	label["url"] = fmt.Sprintf("%s/repos/%s/%s/labels/%s", s.URL, s.owner, repo.data["name"], name)
+	repo.labels[strings.ToLower(name)] = label
+
+	localWriteJSON(w, http.StatusCreated, label)
+}
+
+func (s *localGitHubServer) getLabel(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	label, ok := repo.labels[strings.ToLower(r.PathValue("name"))]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	localWriteJSON(w, http.StatusOK, label)
+}
+
+func (s *localGitHubServer) editLabel(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	label, ok := repo.labels[strings.ToLower(r.PathValue("name"))]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	if newName, ok := body["new_name"].(string); ok && newName != "" {
+		delete(repo.labels, strings.ToLower(r.PathValue("name")))
+		delete(body, "new_name")
+		body["name"] = newName
+		repo.labels[strings.ToLower(newName)] = label
+	}
+	localMerge(label, body)
+
+	localWriteJSON(w, http.StatusOK, label)
+}
+
+func (s *localGitHubServer) deleteLabel(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	name := strings.ToLower(r.PathValue("name"))
+	if _, ok := repo.labels[name]; !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	delete(repo.labels, name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Rulesets
+
+func (s *localGitHubServer) newRuleset(body map[string]any, sourceType, source string) map[string]any {
+	id := s.nextID()
+	ruleset := map[string]any{
+		"id":            id,
+		"node_id":       fmt.Sprintf("RRS_%d", id),
+		"source_type":   sourceType,
+		"source":        source,
+		"rules":         []any{},
+		"bypass_actors": []any{},
+		"created_at":    localTimestamp(),
+		"updated_at":    localTimestamp(),
+	}
+	localMerge(ruleset, body)
+	return ruleset
+}
+
+func (s *localGitHubServer) createRepositoryRuleset(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	ruleset := s.newRuleset(body, "Repository", repo.data["full_name"].(string))
+	repo.rulesets[localInt64(ruleset["id"])] = ruleset
+
+	localWriteJSON(w, http.StatusCreated, ruleset)
+}
+
+func (s *localGitHubServer) getRepositoryRuleset(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	localGetRuleset(w, r, repo.rulesets)
+}
+
+func (s *localGitHubServer) updateRepositoryRuleset(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	localUpdateRuleset(w, r, repo.rulesets)
+}
+
+func (s *localGitHubServer) deleteRepositoryRuleset(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	localDeleteRuleset(w, r, repo.rulesets)
+}
+
+func (s *localGitHubServer) createOrganizationRuleset(w http.ResponseWriter, r *http.Request) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	ruleset := s.newRuleset(body, "Organization", s.owner)
+	s.orgRulesets[localInt64(ruleset["id"])] = ruleset
+
+	localWriteJSON(w, http.StatusCreated, ruleset)
+}
+
+func (s *localGitHubServer) getOrganizationRuleset(w http.ResponseWriter, r *http.Request) {
+	localGetRuleset(w, r, s.orgRulesets)
+}
+
+func (s *localGitHubServer) updateOrganizationRuleset(w http.ResponseWriter, r *http.Request) {
+	localUpdateRuleset(w, r, s.orgRulesets)
+}
+
+func (s *localGitHubServer) deleteOrganizationRuleset(w http.ResponseWriter, r *http.Request) {
+	localDeleteRuleset(w, r, s.orgRulesets)
+}
+
+func localGetRuleset(w http.ResponseWriter, r *http.Request, rulesets map[int64]map[string]any) {
+	ruleset, ok := rulesets[localInt64(r.PathValue("id"))]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	localWriteJSON(w, http.StatusOK, ruleset)
+}
+
+func localUpdateRuleset(w http.ResponseWriter, r *http.Request, rulesets map[int64]map[string]any) {
+	ruleset, ok := rulesets[localInt64(r.PathValue("id"))]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	localMerge(ruleset, body)
+	ruleset["updated_at"] = localTimestamp()
+
+	localWriteJSON(w, http.StatusOK, ruleset)
+}
+
+func localDeleteRuleset(w http.ResponseWriter, r *http.Request, rulesets map[int64]map[string]any) {
+	id := localInt64(r.PathValue("id"))
+	if _, ok := rulesets[id]; !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	delete(rulesets, id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Actions secrets
+
+func (s *localGitHubServer) getSecretPublicKey(w http.ResponseWriter, _ *http.Request, _ *localRepository) {
+	localWriteJSON(w, http.StatusOK, map[string]any{
+		"key_id": localSecretKeyID,
+		"key":    base64.StdEncoding.EncodeToString(s.secretPublicKey[:]),
+	})
+}
+
+// openSecret decrypts a sealed box encrypted value as sent by the provider.
+func (s *localGitHubServer) openSecret(body map[string]any) (string, error) {
+	if keyID, _ := body["key_id"].(string); keyID != localSecretKeyID {
+		return "", fmt.Errorf("unknown key_id %q", keyID)
+	}
+
+	encrypted, _ := body["encrypted_value"].(string)
+	sealed, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, ok := box.OpenAnonymous(nil, sealed, s.secretPublicKey, s.secretPrivateKey)
+	if !ok {
+		return "", fmt.Errorf("encrypted_value could not be decrypted with the public key")
+	}
+	return string(plaintext), nil
+}
+
+// putSecret stores a secret, returning 201 for new secrets and 204 for updated ones like GitHub.
+func (s *localGitHubServer) putSecret(w http.ResponseWriter, r *http.Request, secrets map[string]map[string]any, path string) (map[string]any, bool) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return nil, false
+	}
+
+	plaintext, err := s.openSecret(body)
+	if err != nil {
+		localError(w, http.StatusUnprocessableEntity, err.Error())
+		return nil, false
+	}
+	s.secretValues[path] = plaintext
+
+	name := r.PathValue("name")
+	status := http.StatusNoContent
+	secret, exists := secrets[name]
+	if !exists {
+		status = http.StatusCreated
+		secret = map[string]any{"name": name, "created_at": localTimestamp()}
+		secrets[name] = secret
+	}
+	secret["updated_at"] = localTimestamp()
+	delete(body, "encrypted_value")
+	delete(body, "key_id")
+	localMerge(secret, body)
+
+	w.WriteHeader(status)
+	return secret, true
+}
+
+func (s *localGitHubServer) getRepositorySecret(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	secret, ok := repo.secrets[r.PathValue("name")]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	localWriteJSON(w, http.StatusOK, secret)
+}
+
+func (s *localGitHubServer) putRepositorySecret(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	s.putSecret(w, r, repo.secrets, fmt.Sprintf("repos/%s/%s", repo.data["name"], r.PathValue("name")))
+}
+
+func (s *localGitHubServer) deleteRepositorySecret(w http.ResponseWriter, r *http.Request, repo *localRepository) {
+	name := r.PathValue("name")
+	if _, ok := repo.secrets[name]; !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	delete(repo.secrets, name)
+	delete(s.secretValues, fmt.Sprintf("repos/%s/%s", repo.data["name"], name))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *localGitHubServer) getOrganizationSecret(w http.ResponseWriter, r *http.Request) {
+	secret, ok := s.orgSecrets[r.PathValue("name")]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	localWriteJSON(w, http.StatusOK, secret)
+}
+
+func (s *localGitHubServer) putOrganizationSecret(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	secret, ok := s.putSecret(w, r, s.orgSecrets, "orgs/"+name)
+	if ok {
+		secret["selected_repositories_url"] = fmt.Sprintf("%s/orgs/%s/actions/secrets/%s/repositories", s.URL, s.owner, name)
+	}
+}
+
+func (s *localGitHubServer) deleteOrganizationSecret(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	if _, ok := s.orgSecrets[name]; !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+	delete(s.orgSecrets, name)
+	delete(s.secretValues, "orgs/"+name)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *localGitHubServer) listOrganizationSecretRepositories(w http.ResponseWriter, r *http.Request) {
+	secret, ok := s.orgSecrets[r.PathValue("name")]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	repositories := make([]any, 0)
+	ids, _ := secret["selected_repository_ids"].([]any)
+	for _, id := range ids {
+		for _, repo := range s.repositories {
+			if localInt64(repo.data["id"]) == localInt64(id) {
+				repositories = append(repositories, repo.data)
+			}
+		}
+	}
+
+	localWriteJSON(w, http.StatusOK, map[string]any{
+		"total_count":  len(repositories),
+		"repositories": repositories,
+	})
+}
+
+// Teams
+
+func (s *localGitHubServer) withTeamSlug(handler func(http.ResponseWriter, *http.Request, map[string]any)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		for _, team := range s.teams {
+			if team["slug"] == r.PathValue("slug") {
+				handler(w, r, team)
+				return
+			}
+		}
+		localError(w, http.StatusNotFound, "Not Found")
+	}
+}
+
+func (s *localGitHubServer) withTeamID(handler func(http.ResponseWriter, *http.Request, map[string]any)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		team, ok := s.teams[localInt64(r.PathValue("id"))]
+		if !ok || localInt64(r.PathValue("org_id")) != s.organizationID() {
+			localError(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		handler(w, r, team)
+	}
+}
+
+var localSlugRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+
+// setTeamParent replaces the parent_team_id of a request body with the parent team object GitHub returns.
+func (s *localGitHubServer) setTeamParent(team, body map[string]any) {
+	parentID, ok := body["parent_team_id"]
+	if !ok {
+		return
+	}
+	delete(body, "parent_team_id")
+
+	team["parent"] = nil
+	if parent, ok := s.teams[localInt64(parentID)]; ok {
+		team["parent"] = map[string]any{
+			"id":      parent["id"],
+			"node_id": parent["node_id"],
+			"slug":    parent["slug"],
+			"name":    parent["name"],
+		}
+	}
+}
+
+func (s *localGitHubServer) createTeam(w http.ResponseWriter, r *http.Request) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	name, _ := body["name"].(string)
+	slug := strings.Trim(localSlugRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	for _, team := range s.teams {
+		if team["slug"] == slug {
+			localError(w, http.StatusUnprocessableEntity, "Name must be unique for this org")
+			return
+		}
+	}
+
+	id := s.nextID()
+	team := map[string]any{
+		"id":                   id,
+		"node_id":              fmt.Sprintf("T_%d", id),
+		"slug":                 slug,
+		"description":          "",
+		"privacy":              "secret",
+		"notification_setting": "notifications_enabled",
+		"permission":           "pull",
+		"members_count":        0,
+		"repos_count":          0,
+		"parent":               nil,
+	}
+	s.setTeamParent(team, body)
+	delete(body, "maintainers")
+	delete(body, "repo_names")
+	localMerge(team, body)
+	s.teams[id] = team
+
+	localWriteJSON(w, http.StatusCreated, team)
+}
+
+func (s *localGitHubServer) getTeam(w http.ResponseWriter, _ *http.Request, team map[string]any) {
+	localWriteJSON(w, http.StatusOK, team)
+}
+
+func (s *localGitHubServer) editTeam(w http.ResponseWriter, r *http.Request, team map[string]any) {
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	s.setTeamParent(team, body)
+	localMerge(team, body)
+	if name, ok := body["name"].(string); ok {
+		team["slug"] = strings.Trim(localSlugRegexp.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	}
+
+	localWriteJSON(w, http.StatusOK, team)
+}
+
+func (s *localGitHubServer) deleteTeam(w http.ResponseWriter, _ *http.Request, team map[string]any) {
+	id := localInt64(team["id"])
+	delete(s.teams, id)
+	for _, repo := range s.repositories {
+		delete(repo.teams, id)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *localGitHubServer) listTeamMembers(w http.ResponseWriter, _ *http.Request, _ map[string]any) {
+	localWriteJSON(w, http.StatusOK, []any{})
+}
+
+func (s *localGitHubServer) teamRepository(w http.ResponseWriter, r *http.Request) (*localRepository, bool) {
+	repo, ok := s.repositories[r.PathValue("repo")]
+	if !ok || r.PathValue("owner") != s.owner {
+		localError(w, http.StatusNotFound, "Not Found")
+		return nil, false
+	}
+	return repo, true
+}
+
+func (s *localGitHubServer) getTeamRepository(w http.ResponseWriter, r *http.Request, team map[string]any) {
+	repo, ok := s.teamRepository(w, r)
+	if !ok {
+		return
+	}
+	permission, ok := repo.teams[localInt64(team["id"])]
+	if !ok {
+		localError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	result := make(map[string]any, len(repo.data)+1)
+	localMerge(result, repo.data)
+	result["role_name"] = permission
+
+	localWriteJSON(w, http.StatusOK, result)
+}
+
+func (s *localGitHubServer) addTeamRepository(w http.ResponseWriter, r *http.Request, team map[string]any) {
+	repo, ok := s.teamRepository(w, r)
+	if !ok {
+		return
+	}
+	body, ok := localReadJSON(w, r)
+	if !ok {
+		return
+	}
+
+	permission, _ := body["permission"].(string)
+	if permission == "" {
+		permission = "pull"
+	}
+	repo.teams[localInt64(team["id"])] = map[string]string{"pull": "read", "push": "write"}[permission]
+	if repo.teams[localInt64(team["id"])] == "" {
+		repo.teams[localInt64(team["id"])] = permission
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *localGitHubServer) removeTeamRepository(w http.ResponseWriter, r *http.Request, team map[string]any) {
+	repo, ok := s.teamRepository(w, r)
+	if !ok {
+		return
+	}
+	delete(repo.teams, localInt64(team["id"]))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GraphQL
+
+// graphQL answers the GraphQL queries and mutations of the repository lookups and branch protection rules. As
+// the client rejects fields it did not select, responses only contain fields of the selected fragments.
+func (s *localGitHubServer) graphQL(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Query     string         `json:"query"`
+		Variables map[string]any `json:"variables"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		localError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	match := graphQLOperationRegexp.FindStringSubmatch(request.Query)
+	if match == nil {
+		localGraphQLError(w, "", "unsupported query")
+		return
+	}
+
+	input, _ := request.Variables["input"].(map[string]any)
+	switch field := match[1]; field {
+	case "node":
+		s.graphQLNode(w, request.Query, request.Variables)
+	case "repository":
+		repo, ok := s.repositories[fmt.Sprint(request.Variables["name"])]
+		if !ok || request.Variables["owner"] != s.owner {
+			localGraphQLNotFound(w, field, fmt.Sprintf("Could not resolve to a Repository with the name '%s/%s'.", request.Variables["owner"], request.Variables["name"]))
+			return
+		}
+		localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{field: map[string]any{"id": repo.data["node_id"]}}})
+	case "organization":
+		s.graphQLOrganization(w, request.Query, request.Variables)
+	case "createBranchProtectionRule":
+		id := fmt.Sprintf("BPR_%d", s.nextID())
+		rule := map[string]any{"id": id}
+		localMerge(rule, input)
+		s.branchProtections[id] = rule
+		localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{field: map[string]any{"branchProtectionRule": map[string]any{"id": id}}}})
+	case "updateBranchProtectionRule":
+		id := fmt.Sprint(input["branchProtectionRuleId"])
+		rule, ok := s.branchProtections[id]
+		if !ok {
+			localGraphQLNotFound(w, field, fmt.Sprintf("Could not resolve to a node with the global id of '%s'", id))
+			return
+		}
+		delete(input, "branchProtectionRuleId")
+		localMerge(rule, input)
+		localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{field: map[string]any{"branchProtectionRule": map[string]any{"id": id}}}})
+	case "deleteBranchProtectionRule":
+		id := fmt.Sprint(input["branchProtectionRuleId"])
+		if _, ok := s.branchProtections[id]; !ok {
+			localGraphQLNotFound(w, field, fmt.Sprintf("Could not resolve to a node with the global id of '%s'", id))
+			return
+		}
+		delete(s.branchProtections, id)
+		localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{field: map[string]any{"clientMutationId": nil}}})
+	default:
+		localGraphQLError(w, field, fmt.Sprintf("local GitHub server does not implement the GraphQL field %s", field))
+	}
+}
+
+func localGraphQLError(w http.ResponseWriter, field, message string) {
+	localWriteJSON(w, http.StatusOK, map[string]any{
+		"data":   nil,
+		"errors": []any{map[string]any{"path": []any{field}, "message": message}},
+	})
+}
+
+func localGraphQLNotFound(w http.ResponseWriter, field, message string) {
+	localWriteJSON(w, http.StatusOK, map[string]any{
+		"data":   map[string]any{field: nil},
+		"errors": []any{map[string]any{"type": "NOT_FOUND", "path": []any{field}, "message": message}},
+	})
+}
+
+func (s *localGitHubServer) graphQLNode(w http.ResponseWriter, query string, variables map[string]any) {
+	id := fmt.Sprint(variables["id"])
+
+	if rule, ok := s.branchProtections[id]; ok {
+		node := map[string]any{"id": id}
+		if strings.Contains(query, "on BranchProtectionRule") {
+			node = s.graphQLBranchProtectionRule(rule)
+		}
+		localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{"node": node}})
+		return
+	}
+
+	for _, repo := range s.repositories {
+		if repo.data["node_id"] != id {
+			continue
+		}
+
+		node := map[string]any{"id": id}
+		if strings.Contains(query, "on Repository") && strings.Contains(query, "branchProtectionRules") {
+			rules := make([]any, 0)
+			for ruleID, rule := range s.branchProtections {
+				if rule["repositoryId"] == id {
+					rules = append(rules, map[string]any{"id": ruleID, "pattern": rule["pattern"]})
+				}
+			}
+			node["branchProtectionRules"] = map[string]any{
+				"nodes":    rules,
+				"pageInfo": map[string]any{"hasNextPage": false, "endCursor": ""},
+			}
+		}
+		localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{"node": node}})
+		return
+	}
+
+	localGraphQLNotFound(w, "node", fmt.Sprintf("Could not resolve to a node with the global id of '%s'", id))
+}
+
+func (s *localGitHubServer) graphQLBranchProtectionRule(rule map[string]any) map[string]any {
+	node := map[string]any{
+		"id":                           rule["id"],
+		"pattern":                      rule["pattern"],
+		"requiredApprovingReviewCount": 0,
+		"requiredStatusCheckContexts":  []any{},
+		"pushAllowances":               map[string]any{"nodes": []any{}},
+		"reviewDismissalAllowances":    map[string]any{"nodes": []any{}},
+		"bypassForcePushAllowances":    map[string]any{"nodes": []any{}},
+		"bypassPullRequestAllowances":  map[string]any{"nodes": []any{}},
+	}
+	for _, field := range []string{
+		"allowsDeletions", "allowsForcePushes", "blocksCreations", "dismissesStaleReviews", "isAdminEnforced",
+		"requiresApprovingReviews", "requiresCodeOwnerReviews", "requiresCommitSignatures", "requiresLinearHistory",
+		"requiresConversationResolution", "requiresStatusChecks", "requiresStrictStatusChecks", "restrictsPushes",
+		"restrictsReviewDismissals", "requireLastPushApproval", "lockBranch",
+	} {
+		node[field] = rule[field] == true
+	}
+	if count, ok := rule["requiredApprovingReviewCount"]; ok {
+		node["requiredApprovingReviewCount"] = count
+	}
+	if contexts, ok := rule["requiredStatusCheckContexts"].([]any); ok {
+		node["requiredStatusCheckContexts"] = contexts
+	}
+
+	for _, repo := range s.repositories {
+		if repo.data["node_id"] == rule["repositoryId"] {
+			node["repository"] = map[string]any{"id": repo.data["node_id"], "name": repo.data["name"]}
+		}
+	}
+
+	return node
+}
+
+func (s *localGitHubServer) graphQLOrganization(w http.ResponseWriter, query string, variables map[string]any) {
+	if variables["organization"] != s.owner && variables["login"] != s.owner {
+		localGraphQLNotFound(w, "organization", "Could not resolve to an Organization")
+		return
+	}
+	if !strings.Contains(query, "team(slug") {
+		localGraphQLError(w, "organization", "local GitHub server only implements team lookups of organizations")
+		return
+	}
+
+	for _, team := range s.teams {
+		if team["slug"] == variables["slug"] {
+			localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{"organization": map[string]any{"team": map[string]any{"id": team["node_id"]}}}})
+			return
+		}
+	}
+	localWriteJSON(w, http.StatusOK, map[string]any{"data": map[string]any{"organization": map[string]any{"team": nil}}})
+}
+
+func TestLocalGitHubServer(t *testing.T) {
+	server, err := newLocalGitHubServer("local-org", "local-user")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	baseURL, isGHES, err := getBaseURL(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	config := Config{
+		Token:   "local-token",
+		Owner:   server.owner,
+		BaseURL: baseURL,
+		IsGHES:  isGHES,
+	}
+	m, err := config.Meta()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	meta := m.(*Owner)
+
+	if !meta.IsOrganization {
+		t.Fatal("expected the local owner to be an organization")
+	}
+
+	repository := schema.TestResourceDataRaw(t, resourceGithubRepository().Schema, map[string]any{
+		"name":        "local-repo",
+		"description": "A local repository",
+		"auto_init":   true,
+		"topics":      []any{"local"},
+	})
+
+	t.Run("creates and reads repositories", func(t *testing.T) {
+		if diags := resourceGithubRepositoryCreate(t.Context(), repository, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if diags := resourceGithubRepositoryRead(t.Context(), repository, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := repository.Get("description").(string); got != "A local repository" {
+			t.Errorf("got description %q; want %q", got, "A local repository")
+		}
+		if got := repository.Get("default_branch").(string); got != "main" {
+			t.Errorf("got default_branch %q; want %q", got, "main")
+		}
+	})
+
+	t.Run("stores actions secrets encrypted with the public key", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceGithubActionsSecret().Schema, map[string]any{
+			"repository":      "local-repo",
+			"secret_name":     "LOCAL_SECRET",
+			"plaintext_value": "s3cr3t",
+		})
+
+		if diags := resourceGithubActionsSecretCreate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if diags := resourceGithubActionsSecretRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := server.secretValues["repos/local-repo/LOCAL_SECRET"]; got != "s3cr3t" {
+			t.Errorf("got secret value %q; want %q", got, "s3cr3t")
+		}
+		if d.Id() == "" {
+			t.Error("expected the secret to remain in state")
+		}
+	})
+
+	t.Run("manages teams", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceGithubTeam().Schema, map[string]any{
+			"name":    "Local Team",
+			"privacy": "closed",
+		})
+
+		if diags := resourceGithubTeamCreate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if diags := resourceGithubTeamRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if got := d.Get("slug").(string); got != "local-team" {
+			t.Errorf("got slug %q; want %q", got, "local-team")
+		}
+
+		if diags := resourceGithubTeamDelete(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if len(server.teams) != 0 {
+			t.Errorf("got %d teams; want 0", len(server.teams))
+		}
+	})
+
+	t.Run("manages branch protection rules over GraphQL", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceGithubBranchProtection().Schema, map[string]any{
+			"repository_id":          "local-repo",
+			"pattern":                "main",
+			"enforce_admins":         true,
+			"allows_deletions":       false,
+			"require_signed_commits": true,
+		})
+
+		if err := resourceGithubBranchProtectionCreate(d, meta); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := d.Get("enforce_admins").(bool); !got {
+			t.Error("expected enforce_admins to be read back as true")
+		}
+		if got := d.Get("require_signed_commits").(bool); !got {
+			t.Error("expected require_signed_commits to be read back as true")
+		}
+
+		if err := resourceGithubBranchProtectionDelete(d, meta); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if len(server.branchProtections) != 0 {
+			t.Errorf("got %d branch protection rules; want 0", len(server.branchProtections))
+		}
+	})
+
+	t.Run("manages repository rulesets", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryRuleset().Schema, map[string]any{
+			"name":        "local-ruleset",
+			"repository":  "local-repo",
+			"target":      "branch",
+			"enforcement": "active",
+			"conditions": []any{map[string]any{
+				"ref_name": []any{map[string]any{
+					"include": []any{"~DEFAULT_BRANCH"},
+					"exclude": []any{},
+				}},
+			}},
+			"rules": []any{map[string]any{
+				"deletion":         true,
+				"non_fast_forward": true,
+			}},
+		})
+
+		if diags := resourceGithubRepositoryRulesetCreate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if diags := resourceGithubRepositoryRulesetRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if got := d.Get("rules.0.deletion").(bool); !got {
+			t.Error("expected the deletion rule to be read back")
+		}
+		if got := d.Get("conditions.0.ref_name.0.include.0").(string); got != "~DEFAULT_BRANCH" {
+			t.Errorf("got include %q; want %q", got, "~DEFAULT_BRANCH")
+		}
+
+		if diags := resourceGithubRepositoryRulesetDelete(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+	})
+
+	t.Run("removes deleted repositories from state", func(t *testing.T) {
+		if diags := resourceGithubRepositoryDelete(t.Context(), repository, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if diags := resourceGithubRepositoryRead(t.Context(), repository, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if repository.Id() != "" {
+			t.Errorf("expected the repository to be removed from state, got ID %q", repository.Id())
+		}
+	})
+}
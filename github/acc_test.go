@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
@@ -23,12 +24,13 @@ const (
 	organization testMode = "organization"
 	team         testMode = "team"
 	enterprise   testMode = "enterprise"
+	local        testMode = "local"
 )
 
 const testResourcePrefix = "tf-acc-test-"
 
 var (
-	orgTestModes     = []testMode{organization, team, enterprise}
+	orgTestModes     = []testMode{organization, team, enterprise, local}
 	paidOrgTestModes = []testMode{team, enterprise}
 )
 
@@ -94,12 +96,46 @@ var providerFactories = map[string]func() (*schema.Provider, error){
 	},
 }
 
+// protoV5ProviderFactories are used to instantiate the muxed provider server during acceptance testing.
+// They are required for features only served by the plugin framework provider, such as ephemeral resources.
+var protoV5ProviderFactories = map[string]func() (tfprotov5.ProviderServer, error){
+	"github": func() (tfprotov5.ProviderServer, error) {
+		factory, err := ProtoV5ProviderServerFactory(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return factory(), nil
+	},
+}
+
 func TestMain(m *testing.M) {
 	authMode := testMode(os.Getenv("GH_TEST_AUTH_MODE"))
 	if len(authMode) == 0 {
 		authMode = anonymous
 	}
 
+	if authMode == local {
+		// The local test mode targets an in-memory fake of the GitHub API through the base URL override, so the
+		// provider instances created by the acceptance tests pick it up from the environment.
+		server, err := newLocalGitHubServer("local-org", "local-user")
+		if err != nil {
+			fmt.Printf("Error starting local GitHub server: %s\n", err)
+			os.Exit(1)
+		}
+
+		for k, v := range map[string]string{
+			"GITHUB_BASE_URL": server.URL,
+			"GITHUB_OWNER":    server.owner,
+			"GITHUB_USERNAME": server.username,
+			"GITHUB_TOKEN":    "local-token",
+		} {
+			if err := os.Setenv(k, v); err != nil {
+				fmt.Printf("Error setting %s: %s\n", k, err)
+				os.Exit(1)
+			}
+		}
+	}
+
 	u, ok := os.LookupEnv("GITHUB_BASE_URL")
 	if !ok {
 		u = DotComAPIURL
@@ -13,8 +13,13 @@ import (
 
 	"github.com/go-jose/go-jose/v3"
 	"github.com/go-jose/go-jose/v3/jwt"
+	"golang.org/x/oauth2"
 )
 
+// appTokenRefreshWindow is how long before expiry an installation token is re-minted.
+// GitHub issues installation tokens that are valid for one hour.
+const appTokenRefreshWindow = 5 * time.Minute
+
 // GenerateOAuthTokenFromApp generates a GitHub OAuth access token from a set of valid GitHub App credentials.
 // The returned token can be used to interact with both GitHub's REST and GraphQL APIs.
 func GenerateOAuthTokenFromApp(apiURL *url.URL, appID, appInstallationID, pemData string) (string, error) {
@@ -32,39 +37,81 @@ func GenerateOAuthTokenFromApp(apiURL *url.URL, appID, appInstallationID, pemDat
 }
 
 func getInstallationAccessToken(apiURL *url.URL, jwt, installationID string) (string, error) {
-	req, err := http.NewRequest(http.MethodPost, apiURL.JoinPath("app/installations", installationID, "access_tokens").String(), nil)
+	token, err := requestInstallationAccessToken(apiURL, jwt, installationID)
 	if err != nil {
 		return "", err
 	}
 
+	return token.AccessToken, nil
+}
+
+// requestInstallationAccessToken exchanges an app JWT for an installation access token, including its expiry.
+func requestInstallationAccessToken(apiURL *url.URL, jwt, installationID string) (*oauth2.Token, error) {
+	req, err := http.NewRequest(http.MethodPost, apiURL.JoinPath("app/installations", installationID, "access_tokens").String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
 	req.Header.Add("Accept", "application/vnd.github.v3+json")
 	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", jwt))
 
 	res, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	defer func() { _ = res.Body.Close() }()
 
 	resBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if res.StatusCode != http.StatusCreated {
-		return "", fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
+		return nil, fmt.Errorf("failed to create OAuth token from GitHub App: %s", string(resBytes))
 	}
 
 	resData := struct {
-		Token string `json:"token"`
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
 	}{}
 
 	err = json.Unmarshal(resBytes, &resData)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return &oauth2.Token{AccessToken: resData.Token, Expiry: resData.ExpiresAt}, nil
+}
+
+// appTokenSource is an oauth2.TokenSource that mints a new JWT and installation access token every time
+// it is asked for a token. It is intended to be wrapped by NewAppTokenSource so tokens are reused until they
+// are close to expiring.
+type appTokenSource struct {
+	apiURL         *url.URL
+	appID          string
+	installationID string
+	pemData        []byte
+}
+
+func (s *appTokenSource) Token() (*oauth2.Token, error) {
+	appJWT, err := generateAppJWT(s.appID, time.Now(), s.pemData)
+	if err != nil {
+		return nil, err
 	}
 
-	return resData.Token, nil
+	return requestInstallationAccessToken(s.apiURL, appJWT, s.installationID)
+}
+
+// NewAppTokenSource returns an oauth2.TokenSource backed by a set of GitHub App credentials. The installation
+// access token is cached and transparently refreshed shortly before it expires, so it can be shared by long
+// running REST and GraphQL clients.
+func NewAppTokenSource(apiURL *url.URL, appID, appInstallationID, pemData string) oauth2.TokenSource {
+	return oauth2.ReuseTokenSourceWithExpiry(nil, &appTokenSource{
+		apiURL:         apiURL,
+		appID:          appID,
+		installationID: appInstallationID,
+		pemData:        []byte(pemData),
+	}, appTokenRefreshWindow)
 }
 
 func generateAppJWT(appID string, now time.Time, pemData []byte) (string, error) {
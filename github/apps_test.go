@@ -174,3 +174,71 @@ func TestGetInstallationAccessToken(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestAppTokenSource(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri: fmt.Sprintf("/app/installations/%s/access_tokens", testGitHubAppInstallationID),
+			ExpectedHeaders: map[string]string{
+				"Accept": "application/vnd.github.v3+json",
+			},
+
+			ResponseBody: fmt.Sprintf(`{"token": "first-token", "expires_at": "%s"}`, expiresAt.Format(time.RFC3339)),
+			StatusCode:   201,
+		},
+		{
+			ExpectedUri: fmt.Sprintf("/app/installations/%s/access_tokens", testGitHubAppInstallationID),
+			ExpectedHeaders: map[string]string{
+				"Accept": "application/vnd.github.v3+json",
+			},
+
+			ResponseBody: fmt.Sprintf(`{"token": "second-token", "expires_at": "%s"}`, expiresAt.Format(time.RFC3339)),
+			StatusCode:   201,
+		},
+	})
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatalf("could not parse test server url")
+	}
+
+	t.Run("returns the installation token with its expiry", func(t *testing.T) {
+		src := &appTokenSource{
+			apiURL:         u,
+			appID:          testGitHubAppID,
+			installationID: testGitHubAppInstallationID,
+			pemData:        testGitHubAppPrivateKeyPemData,
+		}
+
+		token, err := src.Token()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if token.AccessToken != "first-token" {
+			t.Errorf("Unexpected access token - Found: %s - Expected: %s", token.AccessToken, "first-token")
+		}
+
+		if !token.Expiry.Equal(expiresAt) {
+			t.Errorf("Unexpected expiry - Found: %s - Expected: %s", token.Expiry, expiresAt)
+		}
+	})
+
+	t.Run("reuses the installation token until it is close to expiry", func(t *testing.T) {
+		src := NewAppTokenSource(u, testGitHubAppID, testGitHubAppInstallationID, string(testGitHubAppPrivateKeyPemData))
+
+		for range 3 {
+			token, err := src.Token()
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if token.AccessToken != "second-token" {
+				t.Errorf("Unexpected access token - Found: %s - Expected: %s", token.AccessToken, "second-token")
+			}
+		}
+	})
+}
@@ -17,6 +17,7 @@ import (
 
 type Config struct {
 	Token            string
+	TokenSource      oauth2.TokenSource // Takes precedence over Token when set.
 	Owner            string
 	BaseURL          *url.URL
 	IsGHES           bool
@@ -27,6 +28,16 @@ type Config struct {
 	RetryableErrors  map[int]bool
 	MaxRetries       int
 	ParallelRequests bool
+	RateLimitReserve int
+	RateLimitPacing  bool
+	// DisableResponseCache sends every read to the API instead of serving repeated ones from the response cache.
+	DisableResponseCache bool
+	// BatchRepositoryReads aggregates concurrent reads of existing repositories into batched GraphQL queries.
+	BatchRepositoryReads bool
+
+	rateLimits        *rateLimitBudgets
+	responseCache     *responseCache
+	repositoryBatcher *repositoryBatcher
 }
 
 type Owner struct {
@@ -36,6 +47,10 @@ type Owner struct {
 	v4client       *githubv4.Client
 	StopContext    context.Context
 	IsOrganization bool
+
+	rateLimits        *rateLimitBudgets
+	responseCache     *responseCache
+	repositoryBatcher *repositoryBatcher
 }
 
 const (
@@ -56,9 +71,9 @@ var (
 	GHECAPIHostMatch = regexp.MustCompile(`^api\.[a-zA-Z0-9-]+\.ghe\.com$`)
 )
 
-func RateLimitedHTTPClient(client *http.Client, writeDelay, readDelay, retryDelay time.Duration, parallelRequests bool, retryableErrors map[int]bool, maxRetries int) *http.Client {
+func RateLimitedHTTPClient(client *http.Client, writeDelay, readDelay, retryDelay time.Duration, parallelRequests bool, retryableErrors map[int]bool, maxRetries int, rateLimits *rateLimitBudgets, cache *responseCache) *http.Client {
 	client.Transport = NewEtagTransport(client.Transport)
-	client.Transport = NewRateLimitTransport(client.Transport, WithWriteDelay(writeDelay), WithReadDelay(readDelay), WithParallelRequests(parallelRequests))
+	client.Transport = NewRateLimitTransport(client.Transport, WithWriteDelay(writeDelay), WithReadDelay(readDelay), WithParallelRequests(parallelRequests), WithRateLimitBudgets(rateLimits))
 	client.Transport = logging.NewLoggingHTTPTransport(client.Transport)
 	client.Transport = newPreviewHeaderInjectorTransport(map[string]string{
 		// TODO: remove when Stone Crop preview is moved to general availability in the GraphQL API
@@ -69,26 +84,54 @@ func RateLimitedHTTPClient(client *http.Client, writeDelay, readDelay, retryDela
 		client.Transport = NewRetryTransport(client.Transport, WithRetryDelay(retryDelay), WithRetryableErrors(retryableErrors), WithMaxRetries(maxRetries))
 	}
 
+	// The cache is the outermost transport so cached reads are neither delayed nor retried.
+	if cache != nil {
+		client.Transport = NewCacheTransport(client.Transport, cache)
+	}
+
 	return client
 }
 
 func (c *Config) AuthenticatedHTTPClient() *http.Client {
 	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: c.Token},
-	)
+	ts := c.TokenSource
+	if ts == nil {
+		ts = oauth2.StaticTokenSource(
+			&oauth2.Token{AccessToken: c.Token},
+		)
+	}
 	client := oauth2.NewClient(ctx, ts)
 
-	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
+	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries, c.getRateLimitBudgets(), c.getResponseCache())
+}
+
+// getRateLimitBudgets returns the rate limit budgets shared by all clients of the configuration.
+func (c *Config) getRateLimitBudgets() *rateLimitBudgets {
+	if c.rateLimits == nil {
+		c.rateLimits = newRateLimitBudgets(c.RateLimitReserve, c.RateLimitPacing)
+	}
+	return c.rateLimits
+}
+
+// getResponseCache returns the response cache shared by all clients of the configuration, or nil when it is
+// disabled.
+func (c *Config) getResponseCache() *responseCache {
+	if c.DisableResponseCache {
+		return nil
+	}
+	if c.responseCache == nil {
+		c.responseCache = newResponseCache()
+	}
+	return c.responseCache
 }
 
 func (c *Config) Anonymous() bool {
-	return c.Token == ""
+	return c.Token == "" && c.TokenSource == nil
 }
 
 func (c *Config) AnonymousHTTPClient() *http.Client {
 	client := &http.Client{Transport: &http.Transport{}}
-	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries)
+	return RateLimitedHTTPClient(client, c.WriteDelay, c.ReadDelay, c.RetryDelay, c.ParallelRequests, c.RetryableErrors, c.MaxRetries, c.getRateLimitBudgets(), c.getResponseCache())
 }
 
 func (c *Config) NewGraphQLClient(client *http.Client) (*githubv4.Client, error) {
@@ -164,6 +207,11 @@ func (c *Config) Meta() (any, error) {
 	owner.v4client = v4client
 	owner.v3client = v3client
 	owner.StopContext = context.Background()
+	owner.rateLimits = c.getRateLimitBudgets()
+	owner.responseCache = c.getResponseCache()
+	if c.BatchRepositoryReads {
+		owner.repositoryBatcher = newRepositoryBatcher(owner.StopContext, v4client)
+	}
 
 	_, err = c.ConfigureOwner(&owner)
 	if err != nil {
@@ -158,6 +158,25 @@ func Test_getBaseURL(t *testing.T) {
 	}
 }
 
+func TestConfigResponseCache(t *testing.T) {
+	t.Run("caches responses by default", func(t *testing.T) {
+		config := Config{}
+		if _, ok := config.AnonymousHTTPClient().Transport.(*cacheTransport); !ok {
+			t.Error("Expected the response cache to be the outermost transport")
+		}
+	})
+
+	t.Run("does not cache responses when disabled", func(t *testing.T) {
+		config := Config{DisableResponseCache: true}
+		if _, ok := config.AnonymousHTTPClient().Transport.(*cacheTransport); ok {
+			t.Error("Expected no response cache transport")
+		}
+		if config.getResponseCache() != nil {
+			t.Error("Expected no response cache")
+		}
+	})
+}
+
 func TestAccConfigMeta(t *testing.T) {
 	baseURL, _, err := getBaseURL(DotComAPIURL)
 	if err != nil {
@@ -11,7 +11,8 @@ import (
 
 func dataSourceGithubActionsRegistrationToken() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: dataSourceGithubActionsRegistrationTokenRead,
+		DeprecationMessage: "This data source is deprecated and will be removed in a future release. Use the github_actions_registration_token ephemeral resource instead.",
+		ReadContext:        dataSourceGithubActionsRegistrationTokenRead,
 
 		Schema: map[string]*schema.Schema{
 			"repository": {
@@ -0,0 +1,268 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+// branchProtectionRuleSourceType is the source type of the rules of the branch protection rule of a branch, which
+// aren't part of a ruleset.
+const branchProtectionRuleSourceType github.RulesetSourceType = "BranchProtection"
+
+func dataSourceGithubBranchEffectiveRules() *schema.Resource {
+	// The rules of a branch can come from repository, organization and enterprise rulesets, so the rule representation is
+	// the one of repository rulesets with the required workflows rule of organization rulesets.
+	rule := computedRulesetSchema(resourceGithubRepositoryRuleset().Schema["rules"])
+	rule.Elem.(*schema.Resource).Schema["required_workflows"] = computedRulesetSchema(resourceGithubOrganizationRuleset().Schema["rules"].Elem.(*schema.Resource).Schema["required_workflows"])
+	rule.Description = "The rule, as the 'rules' block of the 'github_repository_ruleset' resource with only this rule set."
+
+	return &schema.Resource{
+		Description: "Get the active rules which apply to a branch, from all the repository, organization and enterprise rulesets and the branch protection rule.",
+		ReadContext: dataSourceGithubBranchEffectiveRulesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the branch.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The active rules which apply to the branch, one for each rule of each ruleset and of the branch protection rule.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the rule, such as 'pull_request' or 'required_status_checks'.",
+						},
+						"ruleset_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the ruleset the rule is from, or 0 for rules of the branch protection rule.",
+						},
+						"ruleset_source_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the source of the ruleset. Can be one of: 'Repository', 'Organization', 'Enterprise' or 'BranchProtection'.",
+						},
+						"ruleset_source": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the source of the ruleset, such as the repository, organization or enterprise, or the pattern of the branch protection rule.",
+						},
+						"rule": rule,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubBranchEffectiveRulesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+
+	rules := make([]map[string]any, 0)
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		branchRules, resp, err := client.Repositories.GetRulesForBranch(ctx, owner, repoName, branch, opts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		rules = append(rules, flattenBranchRules(ctx, branchRules)...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	// Branch protection rules aren't returned with the rules of rulesets, so the one which applies to the branch is
+	// converted to the equivalent rules.
+	protection, err := readBranchProtectionRuleForBranch(ctx, meta.(*Owner).v4client, owner, repoName, branch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if protection != nil {
+		protectionRules, _, _ := branchProtectionRulesetPreview(protection)
+		metadata := github.BranchRuleMetadata{
+			RulesetSourceType: branchProtectionRuleSourceType,
+			RulesetSource:     string(protection.Pattern),
+		}
+		rules = append(rules, flattenBranchRules(ctx, branchProtectionBranchRules(protectionRules, metadata))...)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", owner, repoName, branch))
+	if err := d.Set("rules", rules); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// readBranchProtectionRuleForBranch reads the branch protection rule which applies to a branch. It returns nil if the
+// branch doesn't exist or isn't protected.
+func readBranchProtectionRuleForBranch(ctx context.Context, client *githubv4.Client, owner, repoName, branch string) (*branchProtectionRulesetPreviewRule, error) {
+	var query struct {
+		Repository struct {
+			Ref *struct {
+				BranchProtectionRule *branchProtectionRulesetPreviewRule
+			} `graphql:"ref(qualifiedName: $ref)"`
+		} `graphql:"repository(name: $name, owner: $owner)"`
+	}
+	variables := map[string]any{
+		"name":  githubv4.String(repoName),
+		"owner": githubv4.String(owner),
+		"ref":   githubv4.String("refs/heads/" + branch),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+	if query.Repository.Ref == nil {
+		return nil, nil
+	}
+
+	return query.Repository.Ref.BranchProtectionRule, nil
+}
+
+// branchProtectionBranchRules converts the ruleset equivalent of a branch protection rule to the rules of a branch,
+// with the given metadata.
+func branchProtectionBranchRules(rules *github.RepositoryRulesetRules, metadata github.BranchRuleMetadata) *github.BranchRules {
+	branchRules := &github.BranchRules{}
+	if rules.Creation != nil {
+		branchRules.Creation = []*github.BranchRuleMetadata{&metadata}
+	}
+	if rules.Update != nil {
+		branchRules.Update = []*github.UpdateBranchRule{{BranchRuleMetadata: metadata, Parameters: *rules.Update}}
+	}
+	if rules.Deletion != nil {
+		branchRules.Deletion = []*github.BranchRuleMetadata{&metadata}
+	}
+	if rules.RequiredLinearHistory != nil {
+		branchRules.RequiredLinearHistory = []*github.BranchRuleMetadata{&metadata}
+	}
+	if rules.RequiredSignatures != nil {
+		branchRules.RequiredSignatures = []*github.BranchRuleMetadata{&metadata}
+	}
+	if rules.PullRequest != nil {
+		branchRules.PullRequest = []*github.PullRequestBranchRule{{BranchRuleMetadata: metadata, Parameters: *rules.PullRequest}}
+	}
+	if rules.RequiredStatusChecks != nil {
+		branchRules.RequiredStatusChecks = []*github.RequiredStatusChecksBranchRule{{BranchRuleMetadata: metadata, Parameters: *rules.RequiredStatusChecks}}
+	}
+	if rules.NonFastForward != nil {
+		branchRules.NonFastForward = []*github.BranchRuleMetadata{&metadata}
+	}
+
+	return branchRules
+}
+
+// flattenBranchRules flattens the rules of a branch to a list with one entry for each rule of each ruleset, with the
+// rule itself flattened as the rules of a ruleset.
+func flattenBranchRules(ctx context.Context, branchRules *github.BranchRules) []map[string]any {
+	result := make([]map[string]any, 0)
+	if branchRules == nil {
+		return result
+	}
+
+	add := func(ruleType github.RepositoryRuleType, metadata github.BranchRuleMetadata, rules *github.RepositoryRulesetRules) {
+		rule := flattenRules(ctx, rules, false)
+		// Required workflows are only flattened for organization rulesets, which the rest of the rule doesn't depend on.
+		if rules.Workflows != nil {
+			rule[0].(map[string]any)["required_workflows"] = flattenRules(ctx, rules, true)[0].(map[string]any)["required_workflows"]
+		}
+
+		result = append(result, map[string]any{
+			"type":                string(ruleType),
+			"ruleset_id":          metadata.RulesetID,
+			"ruleset_source_type": string(metadata.RulesetSourceType),
+			"ruleset_source":      metadata.RulesetSource,
+			"rule":                rule,
+		})
+	}
+
+	for _, r := range branchRules.Creation {
+		add(github.RulesetRuleTypeCreation, *r, &github.RepositoryRulesetRules{Creation: &github.EmptyRuleParameters{}})
+	}
+	for _, r := range branchRules.Update {
+		add(github.RulesetRuleTypeUpdate, r.BranchRuleMetadata, &github.RepositoryRulesetRules{Update: &r.Parameters})
+	}
+	for _, r := range branchRules.Deletion {
+		add(github.RulesetRuleTypeDeletion, *r, &github.RepositoryRulesetRules{Deletion: &github.EmptyRuleParameters{}})
+	}
+	for _, r := range branchRules.RequiredLinearHistory {
+		add(github.RulesetRuleTypeRequiredLinearHistory, *r, &github.RepositoryRulesetRules{RequiredLinearHistory: &github.EmptyRuleParameters{}})
+	}
+	for _, r := range branchRules.MergeQueue {
+		add(github.RulesetRuleTypeMergeQueue, r.BranchRuleMetadata, &github.RepositoryRulesetRules{MergeQueue: &r.Parameters})
+	}
+	for _, r := range branchRules.RequiredDeployments {
+		add(github.RulesetRuleTypeRequiredDeployments, r.BranchRuleMetadata, &github.RepositoryRulesetRules{RequiredDeployments: &r.Parameters})
+	}
+	for _, r := range branchRules.RequiredSignatures {
+		add(github.RulesetRuleTypeRequiredSignatures, *r, &github.RepositoryRulesetRules{RequiredSignatures: &github.EmptyRuleParameters{}})
+	}
+	for _, r := range branchRules.PullRequest {
+		add(github.RulesetRuleTypePullRequest, r.BranchRuleMetadata, &github.RepositoryRulesetRules{PullRequest: &r.Parameters})
+	}
+	for _, r := range branchRules.RequiredStatusChecks {
+		add(github.RulesetRuleTypeRequiredStatusChecks, r.BranchRuleMetadata, &github.RepositoryRulesetRules{RequiredStatusChecks: &r.Parameters})
+	}
+	for _, r := range branchRules.NonFastForward {
+		add(github.RulesetRuleTypeNonFastForward, *r, &github.RepositoryRulesetRules{NonFastForward: &github.EmptyRuleParameters{}})
+	}
+	for _, r := range branchRules.CommitMessagePattern {
+		add(github.RulesetRuleTypeCommitMessagePattern, r.BranchRuleMetadata, &github.RepositoryRulesetRules{CommitMessagePattern: &r.Parameters})
+	}
+	for _, r := range branchRules.CommitAuthorEmailPattern {
+		add(github.RulesetRuleTypeCommitAuthorEmailPattern, r.BranchRuleMetadata, &github.RepositoryRulesetRules{CommitAuthorEmailPattern: &r.Parameters})
+	}
+	for _, r := range branchRules.CommitterEmailPattern {
+		add(github.RulesetRuleTypeCommitterEmailPattern, r.BranchRuleMetadata, &github.RepositoryRulesetRules{CommitterEmailPattern: &r.Parameters})
+	}
+	for _, r := range branchRules.BranchNamePattern {
+		add(github.RulesetRuleTypeBranchNamePattern, r.BranchRuleMetadata, &github.RepositoryRulesetRules{BranchNamePattern: &r.Parameters})
+	}
+	for _, r := range branchRules.TagNamePattern {
+		add(github.RulesetRuleTypeTagNamePattern, r.BranchRuleMetadata, &github.RepositoryRulesetRules{TagNamePattern: &r.Parameters})
+	}
+	for _, r := range branchRules.Workflows {
+		add(github.RulesetRuleTypeWorkflows, r.BranchRuleMetadata, &github.RepositoryRulesetRules{Workflows: &r.Parameters})
+	}
+	for _, r := range branchRules.CodeScanning {
+		add(github.RulesetRuleTypeCodeScanning, r.BranchRuleMetadata, &github.RepositoryRulesetRules{CodeScanning: &r.Parameters})
+	}
+	for _, r := range branchRules.CopilotCodeReview {
+		add(github.RulesetRuleTypeCopilotCodeReview, r.BranchRuleMetadata, &github.RepositoryRulesetRules{CopilotCodeReview: &r.Parameters})
+	}
+	for _, r := range branchRules.FileExtensionRestriction {
+		add(github.RulesetRuleTypeFileExtensionRestriction, r.BranchRuleMetadata, &github.RepositoryRulesetRules{FileExtensionRestriction: &r.Parameters})
+	}
+	for _, r := range branchRules.FilePathRestriction {
+		add(github.RulesetRuleTypeFilePathRestriction, r.BranchRuleMetadata, &github.RepositoryRulesetRules{FilePathRestriction: &r.Parameters})
+	}
+	for _, r := range branchRules.MaxFilePathLength {
+		add(github.RulesetRuleTypeMaxFilePathLength, r.BranchRuleMetadata, &github.RepositoryRulesetRules{MaxFilePathLength: &r.Parameters})
+	}
+	for _, r := range branchRules.MaxFileSize {
+		add(github.RulesetRuleTypeMaxFileSize, r.BranchRuleMetadata, &github.RepositoryRulesetRules{MaxFileSize: &r.Parameters})
+	}
+
+	return result
+}
@@ -0,0 +1,186 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubBranchEffectiveRulesRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/test-org/test-repo/rules/branches/main", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[
+			{"type": "deletion", "ruleset_source_type": "Repository", "ruleset_source": "test-org/test-repo", "ruleset_id": 1},
+			{"type": "pull_request", "ruleset_source_type": "Organization", "ruleset_source": "test-org", "ruleset_id": 2, "parameters": {
+				"allowed_merge_methods": ["squash"], "dismiss_stale_reviews_on_push": true, "require_code_owner_review": false,
+				"require_last_push_approval": false, "required_approving_review_count": 2, "required_review_thread_resolution": false
+			}},
+			{"type": "workflows", "ruleset_source_type": "Enterprise", "ruleset_source": "test-enterprise", "ruleset_id": 3, "parameters": {
+				"workflows": [{"path": ".github/workflows/ci.yml", "repository_id": 42, "ref": "refs/heads/main"}]
+			}}
+		]`)
+	})
+
+	mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		if body.Variables["ref"] != "refs/heads/main" {
+			t.Errorf("got ref %v; want refs/heads/main", body.Variables["ref"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"repository": {"ref": {"branchProtectionRule": {
+			"id": "BPR_1",
+			"pattern": "ma*",
+			"allowsDeletions": true,
+			"allowsForcePushes": false,
+			"requiresStatusChecks": true,
+			"requiresStrictStatusChecks": true,
+			"requiredStatusChecks": [{"context": "ci", "app": {"databaseId": 0}}],
+			"bypassForcePushAllowances": {"totalCount": 0},
+			"bypassPullRequestAllowances": {"totalCount": 0}
+		}}}}}`)
+	})
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubBranchEffectiveRules().Schema, map[string]any{
+		"repository": "test-repo",
+		"branch":     "main",
+	})
+
+	if diags := dataSourceGithubBranchEffectiveRulesRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if d.Id() != "test-org/test-repo/main" {
+		t.Errorf("got ID %q; want %q", d.Id(), "test-org/test-repo/main")
+	}
+	if got := d.Get("rules.#").(int); got != 5 {
+		t.Fatalf("got %d rules; want 5", got)
+	}
+	for key, want := range map[string]any{
+		"rules.0.type":                "deletion",
+		"rules.0.ruleset_id":          1,
+		"rules.0.ruleset_source_type": "Repository",
+		"rules.0.rule.0.deletion":     true,
+		"rules.0.rule.0.creation":     false,
+		"rules.1.type":                "pull_request",
+		"rules.1.ruleset_source":      "test-org",
+		"rules.1.rule.0.deletion":     false,
+		"rules.1.rule.0.pull_request.0.required_approving_review_count": 2,
+		"rules.1.rule.0.pull_request.0.allowed_merge_methods.0":         "squash",
+		"rules.2.type":                "workflows",
+		"rules.2.ruleset_source_type": "Enterprise",
+		"rules.2.rule.0.required_workflows.0.required_workflow.#": 1,
+		"rules.3.type":                "required_status_checks",
+		"rules.3.ruleset_id":          0,
+		"rules.3.ruleset_source_type": "BranchProtection",
+		"rules.3.ruleset_source":      "ma*",
+		"rules.3.rule.0.required_status_checks.0.strict_required_status_checks_policy": true,
+		"rules.4.type":                    "non_fast_forward",
+		"rules.4.ruleset_source_type":     "BranchProtection",
+		"rules.4.rule.0.non_fast_forward": true,
+	} {
+		if got := d.Get(key); got != want {
+			t.Errorf("got %s %v; want %v", key, got, want)
+		}
+	}
+
+	workflow := d.Get("rules.2.rule.0.required_workflows.0.required_workflow").(*schema.Set).List()[0].(map[string]any)
+	if workflow["path"] != ".github/workflows/ci.yml" || workflow["repository_id"] != 42 {
+		t.Errorf("unexpected required workflow: %v", workflow)
+	}
+}
+
+func TestGithubBranchEffectiveRulesReadWithoutBranchProtection(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/test-org/test-repo/rules/branches/main", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"type": "deletion", "ruleset_source_type": "Repository", "ruleset_source": "test-org/test-repo", "ruleset_id": 1}]`)
+	})
+	mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"data": {"repository": {"ref": {"branchProtectionRule": null}}}}`)
+	})
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubBranchEffectiveRules().Schema, map[string]any{
+		"repository": "test-repo",
+		"branch":     "main",
+	})
+
+	if diags := dataSourceGithubBranchEffectiveRulesRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("rules.#").(int); got != 1 {
+		t.Fatalf("got %d rules; want 1", got)
+	}
+}
+
+func TestAccGithubBranchEffectiveRulesDataSource(t *testing.T) {
+	t.Run("queries the rules of a branch", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("%srepo-effective-rules-%s", testResourcePrefix, randomID)
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "%s"
+				auto_init = true
+			}
+
+			resource "github_repository_ruleset" "test" {
+				name        = "test"
+				repository  = github_repository.test.name
+				target      = "branch"
+				enforcement = "active"
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+				}
+
+				rules {
+					deletion = true
+				}
+			}
+
+			data "github_branch_effective_rules" "test" {
+				repository = github_repository.test.name
+				branch     = "main"
+
+				depends_on = [github_repository_ruleset.test]
+			}
+		`, repoName)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					// Rulesets of the organization can apply to the branch too.
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckTypeSetElemNestedAttrs("data.github_branch_effective_rules.test", "rules.*", map[string]string{
+							"type":                "deletion",
+							"ruleset_source_type": "Repository",
+							"rule.0.deletion":     "true",
+						}),
+					),
+				},
+			},
+		})
+	})
+}
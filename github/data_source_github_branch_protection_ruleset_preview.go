@@ -0,0 +1,280 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+// branchProtectionRulesetPreviewRule is a branch protection rule, with the fields which matter to rulesets.
+type branchProtectionRulesetPreviewRule struct {
+	ID                             githubv4.ID
+	Pattern                        githubv4.String
+	AllowsDeletions                githubv4.Boolean
+	AllowsForcePushes              githubv4.Boolean
+	BlocksCreations                githubv4.Boolean
+	DismissesStaleReviews          githubv4.Boolean
+	IsAdminEnforced                githubv4.Boolean
+	LockBranch                     githubv4.Boolean
+	RequiredApprovingReviewCount   githubv4.Int
+	RequiredStatusChecks           []branchProtectionRulesetPreviewStatusCheck
+	RequiresApprovingReviews       githubv4.Boolean
+	RequiresCodeOwnerReviews       githubv4.Boolean
+	RequiresCommitSignatures       githubv4.Boolean
+	RequiresConversationResolution githubv4.Boolean
+	RequiresLinearHistory          githubv4.Boolean
+	RequiresStatusChecks           githubv4.Boolean
+	RequiresStrictStatusChecks     githubv4.Boolean
+	RequireLastPushApproval        githubv4.Boolean
+	RestrictsPushes                githubv4.Boolean
+	RestrictsReviewDismissals      githubv4.Boolean
+	BypassForcePushAllowances      struct {
+		TotalCount githubv4.Int
+	} `graphql:"bypassForcePushAllowances(first: 1)"`
+	BypassPullRequestAllowances struct {
+		TotalCount githubv4.Int
+	} `graphql:"bypassPullRequestAllowances(first: 1)"`
+}
+
+type branchProtectionRulesetPreviewStatusCheck struct {
+	Context githubv4.String
+	App     struct {
+		DatabaseID githubv4.Int `graphql:"databaseId"`
+	}
+}
+
+func dataSourceGithubBranchProtectionRulesetPreview() *schema.Resource {
+	repositoryRuleset := resourceGithubRepositoryRuleset().Schema
+
+	return &schema.Resource{
+		Description: "Preview the repository ruleset equivalent to a branch protection rule, to migrate from branch protection to rulesets.",
+		ReadContext: dataSourceGithubBranchProtectionRulesetPreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the repository.",
+			},
+			"pattern": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The pattern of the branch protection rule, such as the branch name of a 'github_branch_protection_v3'.",
+			},
+			"branch_protection_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The node ID of the branch protection rule.",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The target of the equivalent ruleset, which is always 'branch'.",
+			},
+			"bypass_actors": computedRulesetSchema(repositoryRuleset["bypass_actors"]),
+			"conditions":    computedRulesetSchema(repositoryRuleset["conditions"]),
+			"rules":         computedRulesetSchema(repositoryRuleset["rules"]),
+			"unsupported_fields": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The settings of the branch protection rule which have no ruleset equivalent, and aren't part of the preview.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the setting, as in the 'github_branch_protection' resource.",
+						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Why the setting has no ruleset equivalent.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubBranchProtectionRulesetPreviewRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v4client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	pattern := d.Get("pattern").(string)
+
+	var query struct {
+		Repository struct {
+			BranchProtectionRules struct {
+				Nodes    []branchProtectionRulesetPreviewRule
+				PageInfo PageInfo
+			} `graphql:"branchProtectionRules(first: $first, after: $cursor)"`
+		} `graphql:"repository(name: $name, owner: $owner)"`
+	}
+	variables := map[string]any{
+		"first":  githubv4.Int(100),
+		"name":   githubv4.String(repoName),
+		"owner":  githubv4.String(owner),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	var rule *branchProtectionRulesetPreviewRule
+	for rule == nil {
+		err := client.Query(ctx, &query, variables)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for i, node := range query.Repository.BranchProtectionRules.Nodes {
+			if string(node.Pattern) == pattern {
+				rule = &query.Repository.BranchProtectionRules.Nodes[i]
+				break
+			}
+		}
+
+		if !query.Repository.BranchProtectionRules.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = new(query.Repository.BranchProtectionRules.PageInfo.EndCursor)
+	}
+	if rule == nil {
+		return diag.Errorf("no branch protection rule with pattern %q found in repository %s/%s", pattern, owner, repoName)
+	}
+
+	rules, bypassActors, unsupported := branchProtectionRulesetPreview(rule)
+	conditions := &github.RepositoryRulesetConditions{
+		RefName: &github.RepositoryRulesetRefConditionParameters{
+			Include: []string{"refs/heads/" + pattern},
+			Exclude: []string{},
+		},
+	}
+
+	d.SetId(fmt.Sprintf("%v", rule.ID))
+	if err := d.Set("branch_protection_id", fmt.Sprintf("%v", rule.ID)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("target", string(github.RulesetTargetBranch)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("bypass_actors", flattenBypassActors(bypassActors)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("conditions", flattenConditions(ctx, conditions, false)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rules", flattenRules(ctx, rules, false)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("unsupported_fields", unsupported); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// branchProtectionRulesetPreview converts a branch protection rule to the rules and bypass actors of the equivalent
+// ruleset, and lists the settings which can't be converted.
+func branchProtectionRulesetPreview(rule *branchProtectionRulesetPreviewRule) (*github.RepositoryRulesetRules, []*github.BypassActor, []map[string]any) {
+	rules := &github.RepositoryRulesetRules{}
+	unsupported := make([]map[string]any, 0)
+
+	if !rule.AllowsDeletions {
+		rules.Deletion = &github.EmptyRuleParameters{}
+	}
+	if !rule.AllowsForcePushes {
+		rules.NonFastForward = &github.EmptyRuleParameters{}
+	}
+	if rule.RestrictsPushes && rule.BlocksCreations {
+		rules.Creation = &github.EmptyRuleParameters{}
+	}
+	if rule.LockBranch {
+		rules.Update = &github.UpdateRuleParameters{}
+	}
+	if rule.RequiresLinearHistory {
+		rules.RequiredLinearHistory = &github.EmptyRuleParameters{}
+	}
+	if rule.RequiresCommitSignatures {
+		rules.RequiredSignatures = &github.EmptyRuleParameters{}
+	}
+
+	// Branch protection doesn't restrict merge methods. Conversation resolution is only converted along with reviews,
+	// as rulesets only support it as part of the pull request rule, which would also require pull requests.
+	if rule.RequiresApprovingReviews {
+		pullRequest := &github.PullRequestRuleParameters{
+			AllowedMergeMethods:            []github.PullRequestMergeMethod{github.PullRequestMergeMethodMerge, github.PullRequestMergeMethodSquash, github.PullRequestMergeMethodRebase},
+			RequiredReviewThreadResolution: bool(rule.RequiresConversationResolution),
+		}
+		pullRequest.RequiredApprovingReviewCount = int(rule.RequiredApprovingReviewCount)
+		pullRequest.DismissStaleReviewsOnPush = bool(rule.DismissesStaleReviews)
+		pullRequest.RequireCodeOwnerReview = bool(rule.RequiresCodeOwnerReviews)
+		pullRequest.RequireLastPushApproval = bool(rule.RequireLastPushApproval)
+		rules.PullRequest = pullRequest
+	}
+
+	if rule.RequiresStatusChecks {
+		checks := make([]*github.RuleStatusCheck, 0, len(rule.RequiredStatusChecks))
+		for _, check := range rule.RequiredStatusChecks {
+			statusCheck := &github.RuleStatusCheck{Context: string(check.Context)}
+			if check.App.DatabaseID != 0 {
+				statusCheck.IntegrationID = new(int64(check.App.DatabaseID))
+			}
+			checks = append(checks, statusCheck)
+		}
+		slices.SortFunc(checks, func(a, b *github.RuleStatusCheck) int {
+			return strings.Compare(a.Context, b.Context)
+		})
+		rules.RequiredStatusChecks = &github.RequiredStatusChecksRuleParameters{
+			RequiredStatusChecks:             checks,
+			StrictRequiredStatusChecksPolicy: bool(rule.RequiresStrictStatusChecks),
+		}
+	}
+
+	// Admins bypass branch protection unless it's enforced for them, as the admin repository role does rulesets.
+	bypassActors := make([]*github.BypassActor, 0)
+	if !rule.IsAdminEnforced {
+		bypassActors = append(bypassActors, &github.BypassActor{
+			ActorID:    new(int64(5)),
+			ActorType:  new(github.BypassActorTypeRepositoryRole),
+			BypassMode: new(github.BypassModeAlways),
+		})
+	}
+
+	if rule.RestrictsPushes {
+		unsupported = append(unsupported, map[string]any{
+			"field":  PROTECTION_RESTRICTS_PUSHES,
+			"reason": "Rulesets can't restrict pushes to specific actors. Use the update rule, with the actors as bypass actors, instead.",
+		})
+	}
+	if rule.RequiresConversationResolution && !rule.RequiresApprovingReviews {
+		unsupported = append(unsupported, map[string]any{
+			"field":  PROTECTION_REQUIRES_CONVERSATION_RESOLUTION,
+			"reason": "Rulesets only require conversation resolution as part of the pull_request rule, which also requires changes to be made through pull requests.",
+		})
+	}
+	if rule.RestrictsReviewDismissals {
+		unsupported = append(unsupported, map[string]any{
+			"field":  PROTECTION_REQUIRES_APPROVING_REVIEWS + "." + PROTECTION_RESTRICTS_REVIEW_DISMISSALS,
+			"reason": "Rulesets can't restrict who can dismiss reviews.",
+		})
+	}
+	if rule.BypassForcePushAllowances.TotalCount > 0 {
+		unsupported = append(unsupported, map[string]any{
+			"field":  PROTECTION_FORCE_PUSHES_BYPASSERS,
+			"reason": "Bypass actors of rulesets bypass all of their rules, not only the non_fast_forward rule.",
+		})
+	}
+	if rule.BypassPullRequestAllowances.TotalCount > 0 {
+		unsupported = append(unsupported, map[string]any{
+			"field":  PROTECTION_REQUIRES_APPROVING_REVIEWS + "." + PROTECTION_PULL_REQUESTS_BYPASSERS,
+			"reason": "Bypass actors of rulesets bypass all of their rules, not only the pull_request rule.",
+		})
+	}
+
+	return rules, bypassActors, unsupported
+}
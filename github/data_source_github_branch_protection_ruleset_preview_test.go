@@ -0,0 +1,124 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestBranchProtectionRulesetPreview(t *testing.T) {
+	rule := &branchProtectionRulesetPreviewRule{
+		Pattern:                        "main",
+		AllowsDeletions:                false,
+		AllowsForcePushes:              false,
+		IsAdminEnforced:                false,
+		RequiresApprovingReviews:       true,
+		RequiredApprovingReviewCount:   2,
+		RequiresCodeOwnerReviews:       true,
+		RequiresConversationResolution: true,
+		RequiresStatusChecks:           true,
+		RequiresStrictStatusChecks:     true,
+		RestrictsReviewDismissals:      true,
+		RequiredStatusChecks:           []branchProtectionRulesetPreviewStatusCheck{{Context: "ci"}},
+	}
+	rule.BypassForcePushAllowances.TotalCount = 1
+
+	rules, bypassActors, unsupported := branchProtectionRulesetPreview(rule)
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubBranchProtectionRulesetPreview().Schema, map[string]any{})
+	if err := d.Set("rules", flattenRules(t.Context(), rules, false)); err != nil {
+		t.Fatalf("unexpected error setting rules: %v", err)
+	}
+	if err := d.Set("bypass_actors", flattenBypassActors(bypassActors)); err != nil {
+		t.Fatalf("unexpected error setting bypass actors: %v", err)
+	}
+	if err := d.Set("unsupported_fields", unsupported); err != nil {
+		t.Fatalf("unexpected error setting unsupported fields: %v", err)
+	}
+
+	for key, want := range map[string]any{
+		"rules.0.deletion":                                                      true,
+		"rules.0.non_fast_forward":                                              true,
+		"rules.0.creation":                                                      false,
+		"rules.0.required_signatures":                                           false,
+		"rules.0.pull_request.0.required_approving_review_count":                2,
+		"rules.0.pull_request.0.require_code_owner_review":                      true,
+		"rules.0.pull_request.0.required_review_thread_resolution":              true,
+		"rules.0.required_status_checks.0.required_check.#":                     1,
+		"rules.0.required_status_checks.0.strict_required_status_checks_policy": true,
+		"bypass_actors.#":                                                       1,
+		"bypass_actors.0.actor_type":                                            "RepositoryRole",
+		"bypass_actors.0.actor_id":                                              5,
+		"unsupported_fields.#":                                                  2,
+		"unsupported_fields.0.field":                                            "required_pull_request_reviews.restrict_dismissals",
+		"unsupported_fields.1.field":                                            "force_push_bypassers",
+	} {
+		if got := d.Get(key); got != want {
+			t.Errorf("got %s %v; want %v", key, got, want)
+		}
+	}
+
+	t.Run("reports conversation resolution without reviews as unsupported", func(t *testing.T) {
+		rules, _, unsupported := branchProtectionRulesetPreview(&branchProtectionRulesetPreviewRule{
+			Pattern:                        "main",
+			RequiresConversationResolution: true,
+		})
+
+		if rules.PullRequest != nil {
+			t.Errorf("got pull_request rule %+v; want none", rules.PullRequest)
+		}
+		if len(unsupported) != 1 || unsupported[0]["field"] != PROTECTION_REQUIRES_CONVERSATION_RESOLUTION {
+			t.Errorf("got unsupported fields %v; want %s", unsupported, PROTECTION_REQUIRES_CONVERSATION_RESOLUTION)
+		}
+	})
+}
+
+func TestAccGithubBranchProtectionRulesetPreviewDataSource(t *testing.T) {
+	t.Run("previews the ruleset of a branch protection rule", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("%srepo-bp-preview-%s", testResourcePrefix, randomID)
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "%s"
+				auto_init = true
+			}
+
+			resource "github_branch_protection" "test" {
+				repository_id           = github_repository.test.node_id
+				pattern                 = "main"
+				enforce_admins          = true
+				required_linear_history = true
+
+				required_pull_request_reviews {
+					required_approving_review_count = 1
+				}
+			}
+
+			data "github_branch_protection_ruleset_preview" "test" {
+				repository = github_repository.test.name
+				pattern    = github_branch_protection.test.pattern
+			}
+		`, repoName)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("data.github_branch_protection_ruleset_preview.test", "target", "branch"),
+						resource.TestCheckResourceAttr("data.github_branch_protection_ruleset_preview.test", "conditions.0.ref_name.0.include.0", "refs/heads/main"),
+						resource.TestCheckResourceAttr("data.github_branch_protection_ruleset_preview.test", "rules.0.required_linear_history", "true"),
+						resource.TestCheckResourceAttr("data.github_branch_protection_ruleset_preview.test", "rules.0.pull_request.0.required_approving_review_count", "1"),
+						resource.TestCheckResourceAttr("data.github_branch_protection_ruleset_preview.test", "bypass_actors.#", "0"),
+						resource.TestCheckResourceAttr("data.github_branch_protection_ruleset_preview.test", "unsupported_fields.#", "0"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,120 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubCopilotOrganizationSeats() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the Copilot seats of an organization and the last activity of their users.",
+		ReadContext: dataSourceGithubCopilotOrganizationSeatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"total_seats": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of Copilot seats of the organization.",
+			},
+			"seats": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The Copilot seats of the organization.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"login": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login of the user the seat is assigned to.",
+						},
+						"assigning_team": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The slug of the team the seat is assigned through, if any.",
+						},
+						"plan_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Copilot plan of the seat.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the seat was assigned.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the seat was last updated.",
+						},
+						"last_activity_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the user last used Copilot, if ever.",
+						},
+						"last_activity_editor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The editor the user last used Copilot in, if ever.",
+						},
+						"pending_cancellation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the seat is cancelled at, if it's pending cancellation.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubCopilotOrganizationSeatsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	seats, err := listCopilotSeats(ctx, client, orgName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	results := make([]map[string]any, 0, len(seats))
+	for _, seat := range seats {
+		user, _ := seat.GetUser()
+		result := map[string]any{
+			"login":                     user.GetLogin(),
+			"assigning_team":            seat.GetAssigningTeam().GetSlug(),
+			"plan_type":                 seat.GetPlanType(),
+			"created_at":                seat.GetCreatedAt().Format(time.RFC3339),
+			"updated_at":                "",
+			"last_activity_at":          "",
+			"last_activity_editor":      seat.GetLastActivityEditor(),
+			"pending_cancellation_date": seat.GetPendingCancellationDate(),
+		}
+		if seat.UpdatedAt != nil {
+			result["updated_at"] = seat.UpdatedAt.Format(time.RFC3339)
+		}
+		if seat.LastActivityAt != nil {
+			result["last_activity_at"] = seat.LastActivityAt.Format(time.RFC3339)
+		}
+		results = append(results, result)
+	}
+
+	d.SetId(orgName)
+	if err = d.Set("total_seats", len(seats)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("seats", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubCopilotOrganizationSeatsDataSourceRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/copilot/billing/seats", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"total_seats": 2,
+			"seats": [
+				{
+					"assignee": {"login": "octocat", "type": "User"},
+					"assigning_team": {"slug": "engineering"},
+					"plan_type": "business",
+					"created_at": "2026-01-02T03:04:05Z",
+					"last_activity_at": "2026-03-04T05:06:07Z",
+					"last_activity_editor": "vscode/1.100.0"
+				},
+				{"assignee": {"login": "hubot", "type": "User"}, "created_at": "2026-01-02T03:04:05Z"}
+			]
+		}`)
+	})
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubCopilotOrganizationSeats().Schema, map[string]any{})
+	if diags := dataSourceGithubCopilotOrganizationSeatsRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for k, want := range map[string]any{
+		"total_seats":                       2,
+		"seats.0.login":                     "octocat",
+		"seats.0.assigning_team":            "engineering",
+		"seats.0.plan_type":                 "business",
+		"seats.0.created_at":                "2026-01-02T03:04:05Z",
+		"seats.0.last_activity_at":          "2026-03-04T05:06:07Z",
+		"seats.0.last_activity_editor":      "vscode/1.100.0",
+		"seats.1.login":                     "hubot",
+		"seats.1.assigning_team":            "",
+		"seats.1.last_activity_at":          "",
+		"seats.1.pending_cancellation_date": "",
+	} {
+		if got := d.Get(k); got != want {
+			t.Errorf("got %s %v; want %v", k, got, want)
+		}
+	}
+}
+
+func TestAccGithubCopilotOrganizationSeatsDataSource(t *testing.T) {
+	t.Run("reads the Copilot seats of an organization", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasPaidOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `data "github_copilot_organization_seats" "test" {}`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.github_copilot_organization_seats.test", "total_seats"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,178 @@
+package github
+
+import (
+	"context"
+	"maps"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubOrganizationCodeSecurityConfigurations() *schema.Resource {
+	configurationSchema := map[string]*schema.Schema{
+		"configuration_id": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The ID of the code security configuration.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the code security configuration.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The description of the code security configuration.",
+		},
+		"target_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the code security configuration, either `global`, `organization` or `enterprise`.",
+		},
+		"enforcement": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Whether the configuration is enforced.",
+		},
+		"default_for_new_repos": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The new repositories the configuration is applied to by default.",
+		},
+		"html_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The URL of the code security configuration.",
+		},
+		"repositories": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The repositories the configuration is attached to.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repository_id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"full_name": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"status": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+	for _, feature := range codeSecurityConfigurationFeatures {
+		configurationSchema[feature.name] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: feature.description,
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Get the code security configurations of an organization and the repositories they are attached to.",
+		ReadContext: dataSourceGithubOrganizationCodeSecurityConfigurationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"target_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "all",
+				Description:      "The type of the configurations to get, either `all` or `global`.",
+				ValidateDiagFunc: validateValueFunc([]string{"all", "global"}),
+			},
+			"configurations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The code security configurations of the organization.",
+				Elem:        &schema.Resource{Schema: configurationSchema},
+			},
+		},
+	}
+}
+
+func dataSourceGithubOrganizationCodeSecurityConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	var configs []*github.CodeSecurityConfiguration
+	opts := &github.ListOrgCodeSecurityConfigurationOptions{PerPage: maxPerPage, TargetType: d.Get("target_type").(string)}
+	for {
+		page, resp, err := client.Organizations.ListCodeSecurityConfigurations(ctx, orgName, opts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		configs = append(configs, page...)
+
+		if resp.After == "" {
+			break
+		}
+		opts.After = resp.After
+	}
+
+	defaults, _, err := client.Organizations.ListDefaultCodeSecurityConfigurations(ctx, orgName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	defaultForNewRepos := make(map[int64]string, len(defaults))
+	for _, def := range defaults {
+		defaultForNewRepos[def.GetConfiguration().GetID()] = def.GetDefaultForNewRepos()
+	}
+
+	results := make([]map[string]any, 0, len(configs))
+	for _, config := range configs {
+		attachments, err := listCodeSecurityConfigurationRepositories(ctx, client, orgName, config.GetID(), codeSecurityAttachedStatuses+",failed")
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		repositories := make([]map[string]any, 0, len(attachments))
+		for _, attachment := range attachments {
+			repositories = append(repositories, map[string]any{
+				"repository_id": int(attachment.GetRepository().GetID()),
+				"name":          attachment.GetRepository().GetName(),
+				"full_name":     attachment.GetRepository().GetFullName(),
+				"status":        attachment.GetStatus(),
+			})
+		}
+
+		result := map[string]any{
+			"configuration_id":      int(config.GetID()),
+			"name":                  config.Name,
+			"description":           config.Description,
+			"target_type":           config.GetTargetType(),
+			"enforcement":           config.GetEnforcement(),
+			"default_for_new_repos": "none",
+			"html_url":              config.GetHTMLURL(),
+			"repositories":          repositories,
+		}
+		if v, ok := defaultForNewRepos[config.GetID()]; ok {
+			result["default_for_new_repos"] = v
+		}
+		maps.Copy(result, flattenCodeSecurityConfigurationFeatures(config))
+		results = append(results, result)
+	}
+
+	d.SetId(orgName)
+	if err = d.Set("configurations", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGithubOrganizationCodeSecurityConfigurationsDataSourceRead(t *testing.T) {
+	var targetType string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations", func(w http.ResponseWriter, r *http.Request) {
+		targetType = r.URL.Query().Get("target_type")
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			w.Header().Set("Link", `<https://api.github.com/orgs/test-org/code-security/configurations?after=next>; rel="next"`)
+			mustWrite(w, `[{
+				"id": 17,
+				"name": "baseline",
+				"description": "Baseline",
+				"target_type": "organization",
+				"enforcement": "enforced",
+				"html_url": "https://github.com/organizations/test-org/settings/security_products/configurations/edit/17",
+				"dependency_graph": "enabled",
+				"secret_scanning": "disabled"
+			}]`)
+			return
+		}
+		mustWrite(w, `[{"id": 1, "name": "GitHub recommended", "description": "Suggested settings", "target_type": "global", "enforcement": "unenforced"}]`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations/defaults", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"default_for_new_repos": "public", "configuration": {"id": 17}}]`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations/17/repositories", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[
+			{"status": "enforced", "repository": {"id": 42, "name": "blah", "full_name": "test-org/blah"}},
+			{"status": "failed", "repository": {"id": 43, "name": "other", "full_name": "test-org/other"}}
+		]`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations/1/repositories", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[]`)
+	})
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, dataSourceGithubOrganizationCodeSecurityConfigurations().Schema, map[string]any{})
+
+	if diags := dataSourceGithubOrganizationCodeSecurityConfigurationsRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if targetType != "all" {
+		t.Errorf("got target_type %q; want %q", targetType, "all")
+	}
+	if d.Id() != "test-org" {
+		t.Errorf("got ID %q; want %q", d.Id(), "test-org")
+	}
+	if got := d.Get("configurations.#"); got != 2 {
+		t.Fatalf("got %v configurations; want 2", got)
+	}
+	for k, want := range map[string]any{
+		"configurations.0.configuration_id":         17,
+		"configurations.0.name":                     "baseline",
+		"configurations.0.target_type":              "organization",
+		"configurations.0.enforcement":              "enforced",
+		"configurations.0.default_for_new_repos":    "public",
+		"configurations.0.dependency_graph":         "enabled",
+		"configurations.0.secret_scanning":          "disabled",
+		"configurations.0.repositories.#":           2,
+		"configurations.0.repositories.0.full_name": "test-org/blah",
+		"configurations.0.repositories.0.status":    "enforced",
+		"configurations.0.repositories.1.status":    "failed",
+		"configurations.1.configuration_id":         1,
+		"configurations.1.target_type":              "global",
+		"configurations.1.default_for_new_repos":    "none",
+		"configurations.1.repositories.#":           0,
+	} {
+		if got := d.Get(k); got != want {
+			t.Errorf("got %s %v; want %v", k, got, want)
+		}
+	}
+}
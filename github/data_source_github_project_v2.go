@@ -0,0 +1,210 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func dataSourceGithubProjectV2() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubProjectV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"number": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number of the project, owned by the organization or user the provider is configured for.",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The title of the project.",
+			},
+			"short_description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A short description of the project.",
+			},
+			"readme": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The README of the project.",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the project is visible to anyone.",
+			},
+			"closed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the project is closed.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the project.",
+			},
+			"fields": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The fields of the project.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The node ID of the field.",
+						},
+						"database_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The database ID of the field.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the field.",
+						},
+						"data_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The data type of the field.",
+						},
+						"options": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The options of a 'SINGLE_SELECT' field.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"color": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"iterations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The active and upcoming iterations of an 'ITERATION' field.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"title": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"start_date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"duration": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubProjectV2Read(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	meta := m.(*Owner)
+	client := meta.v4client
+
+	var query struct {
+		RepositoryOwner struct {
+			ProjectV2Owner struct {
+				ProjectV2 struct {
+					projectV2Fragment
+					Fields struct {
+						Nodes    []projectV2FieldFragment
+						PageInfo PageInfo
+					} `graphql:"fields(first:100, after:$cursor)"`
+				} `graphql:"projectV2(number:$number)"`
+			} `graphql:"... on ProjectV2Owner"`
+		} `graphql:"repositoryOwner(login:$login)"`
+	}
+	variables := map[string]any{
+		"login":  githubv4.String(meta.name),
+		"number": githubv4.Int(d.Get("number").(int)),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	fields := make([]map[string]any, 0)
+	for {
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, f := range query.RepositoryOwner.ProjectV2Owner.ProjectV2.Fields.Nodes {
+			field := f.flatten()
+			fields = append(fields, map[string]any{
+				"id":          field.ID,
+				"database_id": field.DatabaseID,
+				"name":        field.Name,
+				"data_type":   field.DataType,
+				"options":     field.Options,
+				"iterations":  field.Iterations,
+			})
+		}
+
+		if !query.RepositoryOwner.ProjectV2Owner.ProjectV2.Fields.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = new(query.RepositoryOwner.ProjectV2Owner.ProjectV2.Fields.PageInfo.EndCursor)
+	}
+
+	project := query.RepositoryOwner.ProjectV2Owner.ProjectV2.projectV2Fragment
+	if project.ID == nil {
+		return diag.Errorf("project %d not found for owner %s", d.Get("number").(int), meta.name)
+	}
+	d.SetId(project.ID.(string))
+
+	if err := d.Set("title", string(project.Title)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("short_description", string(project.ShortDescription)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("readme", string(project.Readme)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("public", bool(project.Public)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("closed", bool(project.Closed)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", string(project.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fields", fields); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
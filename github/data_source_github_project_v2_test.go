@@ -0,0 +1,84 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGithubProjectV2DataSourceRead(t *testing.T) {
+	t.Run("reads the project and all pages of its fields", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Variables map[string]any `json:"variables"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if body.Variables["login"] != "test-org" || body.Variables["number"] != float64(7) {
+				t.Errorf("unexpected variables %v", body.Variables)
+			}
+
+			fields, pageInfo := `{"__typename": "ProjectV2Field", "id": "PVTF_1", "databaseId": 41, "name": "Title", "dataType": "TITLE"}`, `{"hasNextPage": true, "endCursor": "c1"}`
+			if body.Variables["cursor"] == "c1" {
+				fields = `{"__typename": "ProjectV2SingleSelectField", "id": "PVTSSF_1", "databaseId": 42, "name": "Status", "dataType": "SINGLE_SELECT",
+					"options": [{"id": "a1", "name": "Todo", "color": "GRAY", "description": ""}]}`
+				pageInfo = `{"hasNextPage": false, "endCursor": "c2"}`
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `{"data": {"repositoryOwner": {"projectV2": {
+				"id": "PVT_1", "number": 7, "title": "Roadmap", "shortDescription": "Plans", "readme": "", "public": true, "closed": false,
+				"url": "https://github.com/orgs/test-org/projects/7",
+				"fields": {"nodes": [`+fields+`], "pageInfo": `+pageInfo+`}
+			}}}}`)
+		})
+		meta := newTestOwner(t, mux, "test-org", true)
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubProjectV2().Schema, map[string]any{
+			"number": 7,
+		})
+
+		if diags := dataSourceGithubProjectV2Read(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if d.Id() != "PVT_1" {
+			t.Errorf("got ID %q; want %q", d.Id(), "PVT_1")
+		}
+		for key, want := range map[string]any{
+			"title":                 "Roadmap",
+			"public":                true,
+			"fields.#":              2,
+			"fields.0.database_id":  41,
+			"fields.1.name":         "Status",
+			"fields.1.options.#":    1,
+			"fields.1.options.0.id": "a1",
+			"fields.1.iterations.#": 0,
+		} {
+			if got := d.Get(key); got != want {
+				t.Errorf("got %s %v; want %v", key, got, want)
+			}
+		}
+	})
+
+	t.Run("errors when the project is not found", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `{"data": {"repositoryOwner": {"projectV2": null}}}`)
+		})
+		meta := newTestOwner(t, mux, "test-org", true)
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubProjectV2().Schema, map[string]any{
+			"number": 7,
+		})
+
+		if diags := dataSourceGithubProjectV2Read(t.Context(), d, meta); !diags.HasError() {
+			t.Error("expected an error")
+		}
+	})
+}
@@ -0,0 +1,99 @@
+package github
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceGithubRepositoryEnvironmentDeploymentProtectionRuleIntegrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGithubRepositoryEnvironmentDeploymentProtectionRuleIntegrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the GitHub repository.",
+			},
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the environment.",
+			},
+			"integrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The GitHub Apps available to provide custom deployment protection rules for the environment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"integration_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"slug": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"integration_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubRepositoryEnvironmentDeploymentProtectionRuleIntegrationsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+
+	results := make([]map[string]any, 0)
+	listOptions := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		integrations, resp, err := client.Repositories.ListCustomDeploymentRuleIntegrations(ctx, owner, repoName, url.PathEscape(envName), listOptions)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, integration := range integrations.AvailableIntegrations {
+			integrationMap := make(map[string]any)
+			integrationMap["integration_id"] = int(integration.GetID())
+			integrationMap["slug"] = integration.GetSlug()
+			integrationMap["integration_url"] = integration.GetIntegrationURL()
+			integrationMap["node_id"] = integration.GetNodeID()
+			results = append(results, integrationMap)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		listOptions.Page = resp.NextPage
+	}
+
+	id, err := buildID(repoName, escapeIDPart(envName))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	if err = d.Set("integrations", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubRepositoryEnvironmentDeploymentProtectionRuleIntegrations(t *testing.T) {
+	t.Run("queries the apps available to an environment", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("%srepo-env-deploy-apps-%s", testResourcePrefix, randomID)
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "%s"
+				auto_init = true
+			}
+
+			resource "github_repository_environment" "env" {
+				repository  = github_repository.test.name
+				environment = "my_env"
+			}
+
+			data "github_repository_environment_deployment_protection_rule_integrations" "test" {
+				repository  = github_repository.test.name
+				environment = github_repository_environment.env.environment
+			}
+	`, repoName)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.github_repository_environment_deployment_protection_rule_integrations.test", "integrations.#"),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,344 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// ruleSuite is a push evaluated against the rulesets of a repository. go-github doesn't support rule suites yet.
+type ruleSuite struct {
+	ID               int64             `json:"id"`
+	ActorID          int64             `json:"actor_id"`
+	ActorName        string            `json:"actor_name"`
+	BeforeSHA        string            `json:"before_sha"`
+	AfterSHA         string            `json:"after_sha"`
+	Ref              string            `json:"ref"`
+	RepositoryID     int64             `json:"repository_id"`
+	RepositoryName   string            `json:"repository_name"`
+	PushedAt         *github.Timestamp `json:"pushed_at,omitempty"`
+	Result           string            `json:"result"`
+	EvaluationResult string            `json:"evaluation_result"`
+	RuleEvaluations  []ruleEvaluation  `json:"rule_evaluations,omitempty"`
+}
+
+// ruleEvaluation is the outcome of a single rule of a rule suite.
+type ruleEvaluation struct {
+	RuleSource struct {
+		Type string `json:"type"`
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"rule_source"`
+	Enforcement string `json:"enforcement"`
+	Result      string `json:"result"`
+	RuleType    string `json:"rule_type"`
+	Details     string `json:"details"`
+}
+
+func dataSourceGithubRulesetRuleSuites() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the evaluations of the rulesets of a repository or organization against recent pushes, including the rules of rulesets in evaluate mode which would have failed.",
+		ReadContext: dataSourceGithubRulesetRuleSuitesRead,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the repository. If not set, the rule suites of all the repositories of the organization are returned.",
+			},
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Only return the rule suites, and the rule evaluations, of this ruleset.",
+			},
+			"ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the rule suites of pushes to this ref, such as 'refs/heads/main'.",
+			},
+			"actor_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the rule suites of pushes by this user.",
+			},
+			"time_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "day",
+				Description:      "The period of time to return the rule suites of, up to now. Can be one of: 'hour', 'day', 'week' or 'month'.",
+				ValidateDiagFunc: validateValueFunc([]string{"hour", "day", "week", "month"}),
+			},
+			"result": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "all",
+				Description:      "Only return the rule suites with this result. Can be one of: 'pass', 'fail', 'bypass' or 'all'.",
+				ValidateDiagFunc: validateValueFunc([]string{"pass", "fail", "bypass", "all"}),
+			},
+			"include_rule_evaluations": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to fetch the rule evaluations of every rule suite, which takes an API request per rule suite. Otherwise they are only fetched for the rule suites which can be counted in 'evaluate_failure_count' or, when 'ruleset_id' is set, filtered by ruleset.",
+			},
+			"max_rule_suites": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          100,
+				Description:      "The maximum number of the most recent rule suites to return.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"evaluate_failure_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of failed rule evaluations of rulesets in evaluate mode, that is of pushes which would have been blocked if the rulesets were active.",
+			},
+			"rule_suites": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The rule suites, most recent first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the rule suite.",
+						},
+						"actor_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the user who pushed.",
+						},
+						"actor_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The login of the user who pushed.",
+						},
+						"before_sha": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The commit the ref pointed to before the push.",
+						},
+						"after_sha": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The commit the ref pointed to after the push.",
+						},
+						"ref": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ref the push was to.",
+						},
+						"repository_id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The ID of the repository the push was to.",
+						},
+						"repository_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the repository the push was to.",
+						},
+						"pushed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the push happened.",
+						},
+						"result": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the active rules. Can be one of: 'pass', 'fail' or 'bypass'.",
+						},
+						"evaluation_result": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the rules of rulesets in evaluate mode, if any. Can be one of: 'pass', 'fail' or 'bypass'.",
+						},
+						"rule_evaluations": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The outcomes of the rules evaluated against the push, if they were fetched.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ruleset_id": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The ID of the ruleset of the rule.",
+									},
+									"source_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the source of the rule, such as 'ruleset' or 'protected_branch'.",
+									},
+									"source_name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the source of the rule.",
+									},
+									"enforcement": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The enforcement of the rule when it was evaluated. Can be one of: 'active', 'evaluate' or 'deleted ruleset'.",
+									},
+									"result": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The outcome of the rule. Can be one of: 'pass' or 'fail'.",
+									},
+									"rule_type": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The type of the rule, such as 'pull_request' or 'required_status_checks'.",
+									},
+									"details": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "Details of the outcome of the rule.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGithubRulesetRuleSuitesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	path := fmt.Sprintf("orgs/%s/rulesets/rule-suites", owner)
+	id := owner
+	if repoName, ok := d.GetOk("repository"); ok {
+		path = fmt.Sprintf("repos/%s/%s/rulesets/rule-suites", owner, repoName)
+		id = fmt.Sprintf("%s/%s", owner, repoName)
+	} else if err := checkOrganization(meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	suites, err := listRuleSuites(ctx, client, path, map[string]string{
+		"ref":               d.Get("ref").(string),
+		"actor_name":        d.Get("actor_name").(string),
+		"time_period":       d.Get("time_period").(string),
+		"rule_suite_result": d.Get("result").(string),
+	}, d.Get("max_rule_suites").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rulesetID := int64(d.Get("ruleset_id").(int))
+	includeEvaluations := d.Get("include_rule_evaluations").(bool)
+	evaluateFailures := 0
+	results := make([]map[string]any, 0, len(suites))
+	for _, suite := range suites {
+		// The rule evaluations are only returned for a single rule suite, so they're only fetched when they're
+		// needed. Only suites with a failed evaluate result have failed rule evaluations of rulesets in evaluate mode.
+		if includeEvaluations || rulesetID != 0 || suite.EvaluationResult == "fail" {
+			suite, err = getRuleSuite(ctx, client, path, suite.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		evaluations := make([]map[string]any, 0, len(suite.RuleEvaluations))
+		for _, evaluation := range suite.RuleEvaluations {
+			if rulesetID != 0 && evaluation.RuleSource.ID != rulesetID {
+				continue
+			}
+			if evaluation.Enforcement == "evaluate" && evaluation.Result == "fail" {
+				evaluateFailures++
+			}
+			evaluations = append(evaluations, map[string]any{
+				"ruleset_id":  evaluation.RuleSource.ID,
+				"source_type": evaluation.RuleSource.Type,
+				"source_name": evaluation.RuleSource.Name,
+				"enforcement": evaluation.Enforcement,
+				"result":      evaluation.Result,
+				"rule_type":   evaluation.RuleType,
+				"details":     evaluation.Details,
+			})
+		}
+		if rulesetID != 0 && len(evaluations) == 0 {
+			continue
+		}
+
+		var pushedAt string
+		if suite.PushedAt != nil {
+			pushedAt = suite.PushedAt.Format(time.RFC3339)
+		}
+		results = append(results, map[string]any{
+			"id":                suite.ID,
+			"actor_id":          suite.ActorID,
+			"actor_name":        suite.ActorName,
+			"before_sha":        suite.BeforeSHA,
+			"after_sha":         suite.AfterSHA,
+			"ref":               suite.Ref,
+			"repository_id":     suite.RepositoryID,
+			"repository_name":   suite.RepositoryName,
+			"pushed_at":         pushedAt,
+			"result":            suite.Result,
+			"evaluation_result": suite.EvaluationResult,
+			"rule_evaluations":  evaluations,
+		})
+	}
+
+	d.SetId(id)
+	if err = d.Set("evaluate_failure_count", evaluateFailures); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("rule_suites", results); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// listRuleSuites lists up to limit of the most recent rule suites at the given path, which is the rule suites of
+// either an organization or a repository.
+func listRuleSuites(ctx context.Context, client *github.Client, path string, params map[string]string, limit int) ([]*ruleSuite, error) {
+	var suites []*ruleSuite
+
+	params["per_page"] = strconv.Itoa(min(maxPerPage, limit))
+	for len(suites) < limit {
+		req, err := client.NewRequest("GET", buildQueryURL(path, params), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page []*ruleSuite
+		resp, err := client.Do(ctx, req, &page)
+		if err != nil {
+			return nil, err
+		}
+		suites = append(suites, page...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		params["page"] = strconv.Itoa(resp.NextPage)
+	}
+
+	return suites[:min(len(suites), limit)], nil
+}
+
+func getRuleSuite(ctx context.Context, client *github.Client, path string, id int64) (*ruleSuite, error) {
+	req, err := client.NewRequest("GET", fmt.Sprintf("%s/%d", path, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	suite := new(ruleSuite)
+	if _, err = client.Do(ctx, req, suite); err != nil {
+		return nil, err
+	}
+
+	return suite, nil
+}
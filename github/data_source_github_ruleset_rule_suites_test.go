@@ -0,0 +1,177 @@
+package github
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// newRuleSuitesTestOwner returns an owner serving two rule suites of test-repo, of which only the first has a failed
+// evaluate result, and counting the requests for the details of each rule suite.
+func newRuleSuitesTestOwner(t *testing.T) (*Owner, map[string]int) {
+	t.Helper()
+
+	var mu sync.Mutex
+	details := make(map[string]int)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/test-org/test-repo/rulesets/rule-suites", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("time_period"); got != "week" {
+			t.Errorf("got time_period %q; want %q", got, "week")
+		}
+		if got := r.URL.Query().Get("rule_suite_result"); got != "all" {
+			t.Errorf("got rule_suite_result %q; want %q", got, "all")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[
+			{"id": 1, "actor_name": "octocat", "ref": "refs/heads/main", "result": "pass", "evaluation_result": "fail"},
+			{"id": 2, "actor_name": "hubot", "ref": "refs/heads/main", "result": "pass", "evaluation_result": "pass"}
+		]`)
+	})
+	mux.HandleFunc("GET /repos/test-org/test-repo/rulesets/rule-suites/1", func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		details["1"]++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"id": 1, "actor_id": 10, "actor_name": "octocat", "ref": "refs/heads/main", "repository_name": "test-repo",
+			"pushed_at": "2026-10-01T12:00:00Z", "result": "pass", "evaluation_result": "fail",
+			"rule_evaluations": [
+				{"rule_source": {"type": "ruleset", "id": 42, "name": "new"}, "enforcement": "evaluate", "result": "fail", "rule_type": "non_fast_forward"},
+				{"rule_source": {"type": "ruleset", "id": 7, "name": "old"}, "enforcement": "active", "result": "pass", "rule_type": "deletion"}
+			]
+		}`)
+	})
+	mux.HandleFunc("GET /repos/test-org/test-repo/rulesets/rule-suites/2", func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		details["2"]++
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"id": 2, "actor_name": "hubot", "ref": "refs/heads/main", "result": "pass", "evaluation_result": "pass",
+			"rule_evaluations": [
+				{"rule_source": {"type": "ruleset", "id": 7, "name": "old"}, "enforcement": "active", "result": "pass", "rule_type": "deletion"}
+			]
+		}`)
+	})
+
+	return newTestOwner(t, mux, "test-org", true), details
+}
+
+func TestGithubRulesetRuleSuitesRead(t *testing.T) {
+	t.Run("filters the rule suites by ruleset", func(t *testing.T) {
+		meta, _ := newRuleSuitesTestOwner(t)
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubRulesetRuleSuites().Schema, map[string]any{
+			"repository":  "test-repo",
+			"ruleset_id":  42,
+			"time_period": "week",
+		})
+
+		if diags := dataSourceGithubRulesetRuleSuitesRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if d.Id() != "test-org/test-repo" {
+			t.Errorf("got ID %q; want %q", d.Id(), "test-org/test-repo")
+		}
+		if got := d.Get("evaluate_failure_count").(int); got != 1 {
+			t.Errorf("got evaluate_failure_count %d; want 1", got)
+		}
+		// Only the rule suite evaluating ruleset 42 is returned, with only the evaluations of that ruleset.
+		if got := d.Get("rule_suites.#").(int); got != 1 {
+			t.Fatalf("got %d rule suites; want 1", got)
+		}
+		for key, want := range map[string]any{
+			"rule_suites.0.id":                            1,
+			"rule_suites.0.actor_name":                    "octocat",
+			"rule_suites.0.pushed_at":                     "2026-10-01T12:00:00Z",
+			"rule_suites.0.evaluation_result":             "fail",
+			"rule_suites.0.rule_evaluations.#":            1,
+			"rule_suites.0.rule_evaluations.0.ruleset_id": 42,
+			"rule_suites.0.rule_evaluations.0.rule_type":  "non_fast_forward",
+		} {
+			if got := d.Get(key); got != want {
+				t.Errorf("got %s %v; want %v", key, got, want)
+			}
+		}
+	})
+
+	t.Run("only fetches the rule evaluations of suites with a failed evaluate result", func(t *testing.T) {
+		meta, details := newRuleSuitesTestOwner(t)
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubRulesetRuleSuites().Schema, map[string]any{
+			"repository":  "test-repo",
+			"time_period": "week",
+		})
+
+		if diags := dataSourceGithubRulesetRuleSuitesRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if details["1"] != 1 || details["2"] != 0 {
+			t.Errorf("got rule suite detail requests %v; want only rule suite 1", details)
+		}
+		if got := d.Get("evaluate_failure_count").(int); got != 1 {
+			t.Errorf("got evaluate_failure_count %d; want 1", got)
+		}
+		if got := d.Get("rule_suites.#").(int); got != 2 {
+			t.Fatalf("got %d rule suites; want 2", got)
+		}
+		if got := d.Get("rule_suites.1.rule_evaluations.#").(int); got != 0 {
+			t.Errorf("got %d rule evaluations of rule suite 2; want none", got)
+		}
+	})
+
+	t.Run("limits the number of rule suites", func(t *testing.T) {
+		meta, details := newRuleSuitesTestOwner(t)
+
+		d := schema.TestResourceDataRaw(t, dataSourceGithubRulesetRuleSuites().Schema, map[string]any{
+			"repository":               "test-repo",
+			"time_period":              "week",
+			"include_rule_evaluations": true,
+			"max_rule_suites":          1,
+		})
+
+		if diags := dataSourceGithubRulesetRuleSuitesRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("rule_suites.#").(int); got != 1 {
+			t.Fatalf("got %d rule suites; want 1", got)
+		}
+		if details["2"] != 0 {
+			t.Errorf("got %d detail requests for rule suite 2; want none", details["2"])
+		}
+		if got := d.Get("rule_suites.0.rule_evaluations.#").(int); got != 2 {
+			t.Errorf("got %d rule evaluations; want 2", got)
+		}
+	})
+}
+
+func TestAccGithubRulesetRuleSuitesDataSource(t *testing.T) {
+	t.Run("queries the rule suites of an organization", func(t *testing.T) {
+		config := `
+			data "github_ruleset_rule_suites" "test" {
+				time_period = "hour"
+				result      = "fail"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasPaidOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("data.github_ruleset_rule_suites.test", "rule_suites.#"),
+						resource.TestCheckResourceAttrSet("data.github_ruleset_rule_suites.test", "evaluate_failure_count"),
+					),
+				},
+			},
+		})
+	})
+}
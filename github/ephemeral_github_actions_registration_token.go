@@ -0,0 +1,97 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ephemeralGithubActionsRegistrationToken{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralGithubActionsRegistrationToken{}
+)
+
+type ephemeralGithubActionsRegistrationToken struct {
+	owner *Owner
+}
+
+type ephemeralGithubActionsRegistrationTokenModel struct {
+	Repository types.String `tfsdk:"repository"`
+	Token      types.String `tfsdk:"token"`
+	ExpiresAt  types.Int64  `tfsdk:"expires_at"`
+}
+
+func NewEphemeralGithubActionsRegistrationToken() ephemeral.EphemeralResource {
+	return &ephemeralGithubActionsRegistrationToken{}
+}
+
+func (r *ephemeralGithubActionsRegistrationToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_actions_registration_token"
+}
+
+func (r *ephemeralGithubActionsRegistrationToken) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generate a GitHub Actions repository registration token that is never persisted to state.",
+		Attributes: map[string]schema.Attribute{
+			"repository": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the repository to get a GitHub Actions registration token for.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The token that has been retrieved.",
+			},
+			"expires_at": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The token expiration date, as a Unix timestamp.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralGithubActionsRegistrationToken) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.owner = frameworkProviderOwner(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *ephemeralGithubActionsRegistrationToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralGithubActionsRegistrationTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.owner == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured provider",
+			"The provider has not been configured yet. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	client := r.owner.v3client
+	owner := r.owner.name
+	repoName := data.Repository.ValueString()
+
+	tflog.Debug(ctx, "Creating a GitHub Actions repository registration token", map[string]any{
+		"owner":      owner,
+		"repository": repoName,
+	})
+	token, _, err := client.Actions.CreateRegistrationToken(ctx, owner, repoName)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating GitHub Actions registration token",
+			fmt.Sprintf("error creating a GitHub Actions repository registration token for %s/%s: %v", owner, repoName, err),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token.GetToken())
+	data.ExpiresAt = types.Int64Value(token.GetExpiresAt().Unix())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
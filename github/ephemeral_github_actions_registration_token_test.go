@@ -0,0 +1,60 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccGithubActionsRegistrationTokenEphemeralResource(t *testing.T) {
+	randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+	repoName := fmt.Sprintf("%srepo-actions-ephregtoken-%s", testResourcePrefix, randomID)
+
+	t.Run("opens a repository registration token without persisting it", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "%[1]s"
+				auto_init = true
+			}
+
+			ephemeral "github_actions_registration_token" "test" {
+				repository = github_repository.test.name
+			}
+
+			provider "echo" {
+				data = ephemeral.github_actions_registration_token.test
+			}
+
+			resource "echo" "test" {}
+		`, repoName)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { skipUnauthenticated(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_10_0),
+			},
+			ProtoV5ProviderFactories: protoV5ProviderFactories,
+			ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+				"echo": echoprovider.NewProviderServer(),
+			},
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					ConfigStateChecks: []statecheck.StateCheck{
+						statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("repository"), knownvalue.StringExact(repoName)),
+						statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+						statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+					},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &ephemeralGithubAppToken{}
+	_ ephemeral.EphemeralResourceWithConfigure = &ephemeralGithubAppToken{}
+)
+
+type ephemeralGithubAppToken struct {
+	owner *Owner
+}
+
+type ephemeralGithubAppTokenModel struct {
+	AppID          types.String `tfsdk:"app_id"`
+	InstallationID types.String `tfsdk:"installation_id"`
+	PemFile        types.String `tfsdk:"pem_file"`
+	Token          types.String `tfsdk:"token"`
+	ExpiresAt      types.Int64  `tfsdk:"expires_at"`
+}
+
+func NewEphemeralGithubAppToken() ephemeral.EphemeralResource {
+	return &ephemeralGithubAppToken{}
+}
+
+func (r *ephemeralGithubAppToken) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_token"
+}
+
+func (r *ephemeralGithubAppToken) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generate a GitHub App installation access token that is never persisted to state.",
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Required:    true,
+				Description: descriptions["app_auth.id"],
+			},
+			"installation_id": schema.StringAttribute{
+				Required:    true,
+				Description: descriptions["app_auth.installation_id"],
+			},
+			"pem_file": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: descriptions["app_auth.pem_file"],
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated installation access token.",
+			},
+			"expires_at": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The time at which the token expires, as a Unix timestamp.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralGithubAppToken) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	r.owner = frameworkProviderOwner(req.ProviderData, &resp.Diagnostics)
+}
+
+func (r *ephemeralGithubAppToken) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralGithubAppTokenModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.owner == nil {
+		resp.Diagnostics.AddError(
+			"Unconfigured provider",
+			"The provider has not been configured yet. Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	// See dataSourceGithubAppTokenRead for why new lines are replaced.
+	pemFile := strings.ReplaceAll(data.PemFile.ValueString(), `\n`, "\n")
+
+	appJWT, err := generateAppJWT(data.AppID.ValueString(), time.Now(), []byte(pemFile))
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating GitHub App JWT", err.Error())
+		return
+	}
+
+	token, err := requestInstallationAccessToken(r.owner.v3client.BaseURL, appJWT, data.InstallationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating GitHub App installation access token", err.Error())
+		return
+	}
+
+	data.Token = types.StringValue(token.AccessToken)
+	data.ExpiresAt = types.Int64Value(token.Expiry.Unix())
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,122 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAccGithubAppTokenEphemeralResource(t *testing.T) {
+	t.Run("creates an application token without error", func(t *testing.T) {
+		expectedAccessToken := "W+2e/zjiMTweDAr2b35toCF+h29l7NW92rJIPvFrCJQK"
+		expectedExpiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		pemData, err := os.ReadFile(testGitHubAppPrivateKeyFile)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		ts := githubApiMock([]*mockResponse{
+			{
+				ExpectedUri: fmt.Sprintf("/app/installations/%s/access_tokens", testGitHubAppInstallationID),
+				ExpectedHeaders: map[string]string{
+					"Accept": "application/vnd.github.v3+json",
+				},
+				ResponseBody: fmt.Sprintf(`{"token": "%s", "expires_at": "%s"}`, expectedAccessToken, expectedExpiresAt.Format(time.RFC3339)),
+				StatusCode:   201,
+			},
+		})
+		defer ts.Close()
+
+		client := github.NewClient(&http.Client{Transport: http.DefaultTransport})
+		u, _ := url.Parse(ts.URL + "/")
+		client.BaseURL = u
+
+		r := &ephemeralGithubAppToken{owner: &Owner{name: "test-owner", v3client: client}}
+
+		schemaResp := &ephemeral.SchemaResponse{}
+		r.Schema(t.Context(), ephemeral.SchemaRequest{}, schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(t.Context())
+
+		req := ephemeral.OpenRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"app_id":          tftypes.NewValue(tftypes.String, testGitHubAppID),
+					"installation_id": tftypes.NewValue(tftypes.String, testGitHubAppInstallationID),
+					"pem_file":        tftypes.NewValue(tftypes.String, string(pemData)),
+					"token":           tftypes.NewValue(tftypes.String, nil),
+					"expires_at":      tftypes.NewValue(tftypes.Number, nil),
+				}),
+			},
+		}
+		resp := &ephemeral.OpenResponse{
+			Result: tfsdk.EphemeralResultData{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, nil),
+			},
+		}
+
+		r.Open(t.Context(), req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+		}
+
+		var token string
+		resp.Diagnostics.Append(resp.Result.GetAttribute(t.Context(), path.Root("token"), &token)...)
+		var expiresAt int64
+		resp.Diagnostics.Append(resp.Result.GetAttribute(t.Context(), path.Root("expires_at"), &expiresAt)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected error: %v", resp.Diagnostics)
+		}
+
+		if token != expectedAccessToken {
+			t.Errorf("Expected %s, got %s", expectedAccessToken, token)
+		}
+
+		if expiresAt != expectedExpiresAt.Unix() {
+			t.Errorf("Expected %d, got %d", expectedExpiresAt.Unix(), expiresAt)
+		}
+	})
+
+	t.Run("errors when the provider is not configured", func(t *testing.T) {
+		r := &ephemeralGithubAppToken{}
+
+		schemaResp := &ephemeral.SchemaResponse{}
+		r.Schema(t.Context(), ephemeral.SchemaRequest{}, schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(t.Context())
+
+		req := ephemeral.OpenRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"app_id":          tftypes.NewValue(tftypes.String, testGitHubAppID),
+					"installation_id": tftypes.NewValue(tftypes.String, testGitHubAppInstallationID),
+					"pem_file":        tftypes.NewValue(tftypes.String, "pem"),
+					"token":           tftypes.NewValue(tftypes.String, nil),
+					"expires_at":      tftypes.NewValue(tftypes.Number, nil),
+				}),
+			},
+		}
+		resp := &ephemeral.OpenResponse{
+			Result: tfsdk.EphemeralResultData{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, nil),
+			},
+		}
+
+		r.Open(t.Context(), req, resp)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Unconfigured provider" {
+			t.Fatalf("Expected an unconfigured provider error, got %v", resp.Diagnostics)
+		}
+	})
+}
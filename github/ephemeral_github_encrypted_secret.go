@@ -0,0 +1,80 @@
+package github
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResource = &ephemeralGithubEncryptedSecret{}
+
+type ephemeralGithubEncryptedSecret struct{}
+
+type ephemeralGithubEncryptedSecretModel struct {
+	PublicKey      types.String `tfsdk:"public_key"`
+	Value          types.String `tfsdk:"value"`
+	EncryptedValue types.String `tfsdk:"encrypted_value"`
+}
+
+func NewEphemeralGithubEncryptedSecret() ephemeral.EphemeralResource {
+	return &ephemeralGithubEncryptedSecret{}
+}
+
+func (r *ephemeralGithubEncryptedSecret) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_encrypted_secret"
+}
+
+func (r *ephemeralGithubEncryptedSecret) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Encrypt a secret value for a GitHub public key without persisting the result to state.",
+		Attributes: map[string]schema.Attribute{
+			"public_key": schema.StringAttribute{
+				Required:    true,
+				Description: "The Base64 encoded public key, e.g. from the `github_actions_public_key` data source.",
+			},
+			"value": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "The plaintext value to encrypt.",
+			},
+			"encrypted_value": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The value encrypted with a libsodium sealed box for the public key, in Base64 format.",
+			},
+		},
+	}
+}
+
+func (r *ephemeralGithubEncryptedSecret) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data ephemeralGithubEncryptedSecretModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	decodedKey, err := base64.StdEncoding.DecodeString(data.PublicKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid public key", "The public key must be Base64 encoded: "+err.Error())
+		return
+	}
+	if len(decodedKey) != 32 {
+		resp.Diagnostics.AddAttributeError(path.Root("public_key"), "Invalid public key", "The public key must be a 32 byte key.")
+		return
+	}
+
+	// The ephemeral key pair of the sealed box is random, so the result differs on every open.
+	cipherText, err := encryptPlaintext(data.Value.ValueString(), data.PublicKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error encrypting the secret value", err.Error())
+		return
+	}
+
+	data.EncryptedValue = types.StringValue(base64.StdEncoding.EncodeToString(cipherText))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
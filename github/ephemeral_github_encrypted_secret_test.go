@@ -0,0 +1,86 @@
+package github
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"golang.org/x/crypto/nacl/box"
+)
+
+func TestEphemeralGithubEncryptedSecretOpen(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	publicKeyB64 := base64.StdEncoding.EncodeToString(publicKey[:])
+
+	open := func(t *testing.T, publicKey, value string) (string, diag.Diagnostics) {
+		r := NewEphemeralGithubEncryptedSecret()
+
+		schemaResp := &ephemeral.SchemaResponse{}
+		r.Schema(t.Context(), ephemeral.SchemaRequest{}, schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(t.Context())
+
+		req := ephemeral.OpenRequest{
+			Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"public_key":      tftypes.NewValue(tftypes.String, publicKey),
+					"value":           tftypes.NewValue(tftypes.String, value),
+					"encrypted_value": tftypes.NewValue(tftypes.String, nil),
+				}),
+			},
+		}
+		resp := &ephemeral.OpenResponse{
+			Result: tfsdk.EphemeralResultData{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, nil),
+			},
+		}
+
+		r.Open(t.Context(), req, resp)
+		if resp.Diagnostics.HasError() {
+			return "", resp.Diagnostics
+		}
+
+		var encrypted string
+		resp.Diagnostics.Append(resp.Result.GetAttribute(t.Context(), path.Root("encrypted_value"), &encrypted)...)
+		return encrypted, resp.Diagnostics
+	}
+
+	t.Run("encrypts a value which can be decrypted with the private key", func(t *testing.T) {
+		encrypted, diags := open(t, publicKeyB64, "super-secret")
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		cipherText, err := base64.StdEncoding.DecodeString(encrypted)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		plaintext, ok := box.OpenAnonymous(nil, cipherText, publicKey, privateKey)
+		if !ok {
+			t.Fatal("failed to decrypt the encrypted value")
+		}
+		if string(plaintext) != "super-secret" {
+			t.Errorf("got %q; want %q", plaintext, "super-secret")
+		}
+	})
+
+	for name, key := range map[string]string{
+		"errors on a key which is not Base64 encoded": "not base64!",
+		"errors on a key of the wrong length":         base64.StdEncoding.EncodeToString([]byte("short")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, diags := open(t, key, "super-secret"); !diags.HasError() {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
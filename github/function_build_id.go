@@ -0,0 +1,52 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &buildIDFunction{}
+
+type buildIDFunction struct{}
+
+func NewBuildIDFunction() function.Function {
+	return &buildIDFunction{}
+}
+
+func (f *buildIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_id"
+}
+
+func (f *buildIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a resource ID from its parts",
+		Description: "Joins the given parts with the `:` separator used by the resource IDs of this provider. " +
+			"Any `:` in a part other than the last one is escaped, as the resources do for values such as environment names.",
+		VariadicParameter: function.StringParameter{
+			Name:        "parts",
+			Description: "The parts of the ID, in order.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *buildIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var parts []string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &parts))
+	if resp.Error != nil {
+		return
+	}
+
+	for i := range len(parts) - 1 {
+		parts[i] = escapeIDPart(parts[i])
+	}
+
+	id, err := buildID(parts...)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, id))
+}
@@ -0,0 +1,63 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runBuildIDFunction(t *testing.T, parts ...string) (string, *function.FuncError) {
+	t.Helper()
+
+	elementTypes := make([]attr.Type, len(parts))
+	elements := make([]attr.Value, len(parts))
+	for i, part := range parts {
+		elementTypes[i] = types.StringType
+		elements[i] = types.StringValue(part)
+	}
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.TupleValueMust(elementTypes, elements)}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	NewBuildIDFunction().Run(t.Context(), req, resp)
+	if resp.Error != nil {
+		return "", resp.Error
+	}
+
+	return resp.Result.Value().(types.String).ValueString(), nil
+}
+
+func TestBuildIDFunction(t *testing.T) {
+	for _, d := range []struct {
+		testName string
+		parts    []string
+		expected string
+	}{
+		{testName: "single part", parts: []string{"repo"}, expected: "repo"},
+		{testName: "two parts", parts: []string{"repo", "SECRET"}, expected: "repo:SECRET"},
+		{testName: "escapes separators in non-final parts", parts: []string{"repo", "env:prod", "SECRET"}, expected: "repo:env??prod:SECRET"},
+		{testName: "keeps separators in the final part", parts: []string{"repo", "path/to:file"}, expected: "repo:path/to:file"},
+	} {
+		t.Run(d.testName, func(t *testing.T) {
+			id, err := runBuildIDFunction(t, d.parts...)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if id != d.expected {
+				t.Errorf("got %q; want %q", id, d.expected)
+			}
+		})
+	}
+
+	t.Run("errors without parts", func(t *testing.T) {
+		if _, err := runBuildIDFunction(t); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
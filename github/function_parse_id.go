@@ -0,0 +1,67 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseIDFunction{}
+
+type parseIDFunction struct{}
+
+func NewParseIDFunction() function.Function {
+	return &parseIDFunction{}
+}
+
+func (f *parseIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_id"
+}
+
+func (f *parseIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Split a resource ID into its parts",
+		Description: "Splits a resource ID built with the `:` separator into exactly `count` parts. " +
+			"The last part receives the remainder of the ID, and escaped separators in the other parts are unescaped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The resource ID to parse.",
+			},
+			function.Int64Parameter{
+				Name:        "count",
+				Description: "The number of parts the ID is expected to have.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *parseIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	var count int64
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &id, &count))
+	if resp.Error != nil {
+		return
+	}
+
+	if count < 1 {
+		resp.Error = function.NewArgumentFuncError(1, "count must be at least 1")
+		return
+	}
+
+	parts, err := parseID(id, int(count))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	for i := range len(parts) - 1 {
+		parts[i] = unescapeIDPart(parts[i])
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, parts))
+}
@@ -0,0 +1,71 @@
+package github
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runParseIDFunction(t *testing.T, id string, count int64) ([]string, *function.FuncError) {
+	t.Helper()
+
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(id), types.Int64Value(count)}),
+	}
+	resp := &function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.StringType)),
+	}
+
+	NewParseIDFunction().Run(t.Context(), req, resp)
+	if resp.Error != nil {
+		return nil, resp.Error
+	}
+
+	var parts []string
+	for _, v := range resp.Result.Value().(types.List).Elements() {
+		parts = append(parts, v.(types.String).ValueString())
+	}
+	return parts, nil
+}
+
+func TestParseIDFunction(t *testing.T) {
+	for _, d := range []struct {
+		testName string
+		id       string
+		count    int64
+		expected []string
+	}{
+		{testName: "two parts", id: "repo:SECRET", count: 2, expected: []string{"repo", "SECRET"}},
+		{testName: "unescapes separators in non-final parts", id: "repo:env??prod:SECRET", count: 3, expected: []string{"repo", "env:prod", "SECRET"}},
+		{testName: "keeps the remainder in the final part", id: "repo:path/to:file", count: 2, expected: []string{"repo", "path/to:file"}},
+	} {
+		t.Run(d.testName, func(t *testing.T) {
+			parts, err := runParseIDFunction(t, d.id, d.count)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !slices.Equal(parts, d.expected) {
+				t.Errorf("got %q; want %q", parts, d.expected)
+			}
+		})
+	}
+
+	for _, d := range []struct {
+		testName string
+		id       string
+		count    int64
+	}{
+		{testName: "errors on an empty id", id: "", count: 2},
+		{testName: "errors on too few parts", id: "repo", count: 2},
+		{testName: "errors on a non-positive count", id: "repo", count: 0},
+	} {
+		t.Run(d.testName, func(t *testing.T) {
+			if _, err := runParseIDFunction(t, d.id, d.count); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseRepositoryFullNameFunction{}
+
+type parseRepositoryFullNameFunction struct{}
+
+type repositoryFullNameModel struct {
+	Owner types.String `tfsdk:"owner"`
+	Name  types.String `tfsdk:"name"`
+}
+
+func NewParseRepositoryFullNameFunction() function.Function {
+	return &parseRepositoryFullNameFunction{}
+}
+
+func (f *parseRepositoryFullNameFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_repository_full_name"
+}
+
+func (f *parseRepositoryFullNameFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a repository full name into its owner and name",
+		Description: "Splits a repository full name of the form `owner/name` into an object with `owner` and `name` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "full_name",
+				Description: "The full name of the repository, e.g. `integrations/terraform-provider-github`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"owner": types.StringType,
+				"name":  types.StringType,
+			},
+		},
+	}
+}
+
+func (f *parseRepositoryFullNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var fullName string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &fullName))
+	if resp.Error != nil {
+		return
+	}
+
+	owner, name, err := splitRepoFullName(fullName)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	if owner == "" || name == "" {
+		resp.Error = function.NewArgumentFuncError(0, "owner and repository name must not be empty")
+		return
+	}
+
+	result := repositoryFullNameModel{
+		Owner: types.StringValue(owner),
+		Name:  types.StringValue(name),
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,50 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseRepositoryFullNameFunction(t *testing.T) {
+	run := func(t *testing.T, fullName string) (map[string]attr.Value, *function.FuncError) {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(fullName)}),
+		}
+		resp := &function.RunResponse{
+			Result: function.NewResultData(types.ObjectUnknown(map[string]attr.Type{
+				"owner": types.StringType,
+				"name":  types.StringType,
+			})),
+		}
+
+		NewParseRepositoryFullNameFunction().Run(t.Context(), req, resp)
+		if resp.Error != nil {
+			return nil, resp.Error
+		}
+		return resp.Result.Value().(types.Object).Attributes(), nil
+	}
+
+	t.Run("splits owner and name", func(t *testing.T) {
+		attrs, err := run(t, "integrations/terraform-provider-github")
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if got := attrs["owner"].(types.String).ValueString(); got != "integrations" {
+			t.Errorf("got owner %q; want %q", got, "integrations")
+		}
+		if got := attrs["name"].(types.String).ValueString(); got != "terraform-provider-github" {
+			t.Errorf("got name %q; want %q", got, "terraform-provider-github")
+		}
+	})
+
+	for _, fullName := range []string{"terraform-provider-github", "a/b/c", "/repo", "owner/"} {
+		t.Run("errors on "+fullName, func(t *testing.T) {
+			if _, err := run(t, fullName); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
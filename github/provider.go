@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/oauth2"
 )
 
 func Provider() *schema.Provider {
@@ -93,6 +94,30 @@ func Provider() *schema.Provider {
 				Default:     false,
 				Description: descriptions["parallel_requests"],
 			},
+			"rate_limit_reserve": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: descriptions["rate_limit_reserve"],
+			},
+			"rate_limit_pacing": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions["rate_limit_pacing"],
+			},
+			"response_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: descriptions["response_cache"],
+			},
+			"batch_repository_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: descriptions["batch_repository_reads"],
+			},
 			"app_auth": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -147,6 +172,7 @@ func Provider() *schema.Provider {
 			"github_actions_repository_access_level":                                resourceGithubActionsRepositoryAccessLevel(),
 			"github_actions_repository_oidc_subject_claim_customization_template":   resourceGithubActionsRepositoryOIDCSubjectClaimCustomizationTemplate(),
 			"github_actions_repository_permissions":                                 resourceGithubActionsRepositoryPermissions(),
+			"github_actions_repository_workflow_settings":                           resourceGithubActionsRepositoryWorkflowSettings(),
 			"github_actions_runner_group":                                           resourceGithubActionsRunnerGroup(),
 			"github_actions_hosted_runner":                                          resourceGithubActionsHostedRunner(),
 			"github_actions_secret":                                                 resourceGithubActionsSecret(),
@@ -161,6 +187,8 @@ func Provider() *schema.Provider {
 			"github_codespaces_organization_secret_repositories":                    resourceGithubCodespacesOrganizationSecretRepositories(),
 			"github_codespaces_secret":                                              resourceGithubCodespacesSecret(),
 			"github_codespaces_user_secret":                                         resourceGithubCodespacesUserSecret(),
+			"github_copilot_organization_seat_assignment":                           resourceGithubCopilotOrganizationSeatAssignment(),
+			"github_copilot_organization_seat_assignments":                          resourceGithubCopilotOrganizationSeatAssignments(),
 			"github_dependabot_organization_secret":                                 resourceGithubDependabotOrganizationSecret(),
 			"github_dependabot_organization_secret_repositories":                    resourceGithubDependabotOrganizationSecretRepositories(),
 			"github_dependabot_organization_secret_repository":                      resourceGithubDependabotOrganizationSecretRepository(),
@@ -171,8 +199,12 @@ func Provider() *schema.Provider {
 			"github_issue_labels":                                                   resourceGithubIssueLabels(),
 			"github_membership":                                                     resourceGithubMembership(),
 			"github_organization_block":                                             resourceOrganizationBlock(),
+			"github_organization_code_security_configuration":                       resourceGithubOrganizationCodeSecurityConfiguration(),
+			"github_organization_code_security_configuration_attachment":            resourceGithubOrganizationCodeSecurityConfigurationAttachment(),
 			"github_organization_custom_role":                                       resourceGithubOrganizationCustomRole(),
 			"github_organization_custom_properties":                                 resourceGithubOrganizationCustomProperties(),
+			"github_organization_interaction_limit":                                 resourceGithubOrganizationInteractionLimit(),
+			"github_organization_members":                                           resourceGithubOrganizationMembers(),
 			"github_organization_project":                                           resourceGithubOrganizationProject(),
 			"github_organization_repository_role":                                   resourceGithubOrganizationRepositoryRole(),
 			"github_organization_role":                                              resourceGithubOrganizationRole(),
@@ -185,10 +217,17 @@ func Provider() *schema.Provider {
 			"github_organization_webhook":                                           resourceGithubOrganizationWebhook(),
 			"github_project_card":                                                   resourceGithubProjectCard(),
 			"github_project_column":                                                 resourceGithubProjectColumn(),
+			"github_project_v2":                                                     resourceGithubProjectV2(),
+			"github_project_v2_field":                                               resourceGithubProjectV2Field(),
+			"github_project_v2_item":                                                resourceGithubProjectV2Item(),
+			"github_project_v2_repository_link":                                     resourceGithubProjectV2RepositoryLink(),
+			"github_project_v2_view":                                                resourceGithubProjectV2View(),
 			"github_release":                                                        resourceGithubRelease(),
+			"github_release_asset":                                                  resourceGithubReleaseAsset(),
 			"github_repository":                                                     resourceGithubRepository(),
 			"github_repository_autolink_reference":                                  resourceGithubRepositoryAutolinkReference(),
 			"github_repository_dependabot_security_updates":                         resourceGithubRepositoryDependabotSecurityUpdates(),
+			"github_repository_code_scanning_default_setup":                         resourceGithubRepositoryCodeScanningDefaultSetup(),
 			"github_repository_collaborator":                                        resourceGithubRepositoryCollaborator(),
 			"github_repository_collaborators":                                       resourceGithubRepositoryCollaborators(),
 			"github_repository_custom_property":                                     resourceGithubRepositoryCustomProperty(),
@@ -196,8 +235,13 @@ func Provider() *schema.Provider {
 			"github_repository_deployment_branch_policy":                            resourceGithubRepositoryDeploymentBranchPolicy(),
 			"github_repository_environment":                                         resourceGithubRepositoryEnvironment(),
 			"github_repository_environment_deployment_policy":                       resourceGithubRepositoryEnvironmentDeploymentPolicy(),
+			"github_repository_environment_deployment_protection_rule":              resourceGithubRepositoryEnvironmentDeploymentProtectionRule(),
 			"github_repository_file":                                                resourceGithubRepositoryFile(),
+			"github_repository_file_change_request":                                 resourceGithubRepositoryFileChangeRequest(),
+			"github_repository_files":                                               resourceGithubRepositoryFiles(),
+			"github_repository_interaction_limit":                                   resourceGithubRepositoryInteractionLimit(),
 			"github_repository_milestone":                                           resourceGithubRepositoryMilestone(),
+			"github_repository_pages":                                               resourceGithubRepositoryPages(),
 			"github_repository_project":                                             resourceGithubRepositoryProject(),
 			"github_repository_pull_request":                                        resourceGithubRepositoryPullRequest(),
 			"github_repository_ruleset":                                             resourceGithubRepositoryRuleset(),
@@ -213,12 +257,14 @@ func Provider() *schema.Provider {
 			"github_user_invitation_accepter":                                       resourceGithubUserInvitationAccepter(),
 			"github_user_ssh_key":                                                   resourceGithubUserSshKey(),
 			"github_enterprise_organization":                                        resourceGithubEnterpriseOrganization(),
+			"github_enterprise_ruleset":                                             resourceGithubEnterpriseRuleset(),
 			"github_enterprise_team":                                                resourceGithubEnterpriseTeam(),
 			"github_enterprise_team_membership":                                     resourceGithubEnterpriseTeamMembership(),
 			"github_enterprise_team_organizations":                                  resourceGithubEnterpriseTeamOrganizations(),
 			"github_enterprise_actions_runner_group":                                resourceGithubActionsEnterpriseRunnerGroup(),
 			"github_enterprise_actions_workflow_permissions":                        resourceGithubEnterpriseActionsWorkflowPermissions(),
 			"github_actions_organization_workflow_permissions":                      resourceGithubActionsOrganizationWorkflowPermissions(),
+			"github_actions_organization_workflow_settings":                         resourceGithubActionsOrganizationWorkflowSettings(),
 			"github_enterprise_security_analysis_settings":                          resourceGithubEnterpriseSecurityAnalysisSettings(),
 			"github_enterprise_cost_center":                                         resourceGithubEnterpriseCostCenter(),
 			"github_enterprise_cost_center_users":                                   resourceGithubEnterpriseCostCenterUsers(),
@@ -244,7 +290,9 @@ func Provider() *schema.Provider {
 			"github_app":                                                            dataSourceGithubApp(),
 			"github_app_token":                                                      dataSourceGithubAppToken(),
 			"github_branch":                                                         dataSourceGithubBranch(),
+			"github_branch_effective_rules":                                         dataSourceGithubBranchEffectiveRules(),
 			"github_branch_protection_rules":                                        dataSourceGithubBranchProtectionRules(),
+			"github_branch_protection_ruleset_preview":                              dataSourceGithubBranchProtectionRulesetPreview(),
 			"github_collaborators":                                                  dataSourceGithubCollaborators(),
 			"github_codespaces_organization_public_key":                             dataSourceGithubCodespacesOrganizationPublicKey(),
 			"github_codespaces_organization_secrets":                                dataSourceGithubCodespacesOrganizationSecrets(),
@@ -252,6 +300,7 @@ func Provider() *schema.Provider {
 			"github_codespaces_secrets":                                             dataSourceGithubCodespacesSecrets(),
 			"github_codespaces_user_public_key":                                     dataSourceGithubCodespacesUserPublicKey(),
 			"github_codespaces_user_secrets":                                        dataSourceGithubCodespacesUserSecrets(),
+			"github_copilot_organization_seats":                                     dataSourceGithubCopilotOrganizationSeats(),
 			"github_dependabot_organization_public_key":                             dataSourceGithubDependabotOrganizationPublicKey(),
 			"github_dependabot_organization_secrets":                                dataSourceGithubDependabotOrganizationSecrets(),
 			"github_dependabot_public_key":                                          dataSourceGithubDependabotPublicKey(),
@@ -261,6 +310,7 @@ func Provider() *schema.Provider {
 			"github_issue_labels":                                                   dataSourceGithubIssueLabels(),
 			"github_membership":                                                     dataSourceGithubMembership(),
 			"github_organization":                                                   dataSourceGithubOrganization(),
+			"github_organization_code_security_configurations":                      dataSourceGithubOrganizationCodeSecurityConfigurations(),
 			"github_organization_custom_role":                                       dataSourceGithubOrganizationCustomRole(),
 			"github_organization_custom_properties":                                 dataSourceGithubOrganizationCustomProperties(),
 			"github_organization_external_identities":                               dataSourceGithubOrganizationExternalIdentities(),
@@ -276,6 +326,7 @@ func Provider() *schema.Provider {
 			"github_organization_teams":                                             dataSourceGithubOrganizationTeams(),
 			"github_organization_webhooks":                                          dataSourceGithubOrganizationWebhooks(),
 			"github_organization_app_installations":                                 dataSourceGithubOrganizationAppInstallations(),
+			"github_project_v2":                                                     dataSourceGithubProjectV2(),
 			"github_ref":                                                            dataSourceGithubRef(),
 			"github_release":                                                        dataSourceGithubRelease(),
 			"github_release_asset":                                                  dataSourceGithubReleaseAsset(),
@@ -294,6 +345,7 @@ func Provider() *schema.Provider {
 			"github_repository_teams":                                               dataSourceGithubRepositoryTeams(),
 			"github_repository_webhooks":                                            dataSourceGithubRepositoryWebhooks(),
 			"github_rest_api":                                                       dataSourceGithubRestApi(),
+			"github_ruleset_rule_suites":                                            dataSourceGithubRulesetRuleSuites(),
 			"github_ssh_keys":                                                       dataSourceGithubSshKeys(),
 			"github_team":                                                           dataSourceGithubTeam(),
 			"github_tree":                                                           dataSourceGithubTree(),
@@ -315,6 +367,7 @@ func Provider() *schema.Provider {
 			"github_enterprise_scim_users":                                          dataSourceGithubEnterpriseSCIMUsers(),
 			"github_enterprise_scim_user":                                           dataSourceGithubEnterpriseSCIMUser(),
 			"github_repository_environment_deployment_policies":                     dataSourceGithubRepositoryEnvironmentDeploymentPolicies(),
+			"github_repository_environment_deployment_protection_rule_integrations": dataSourceGithubRepositoryEnvironmentDeploymentProtectionRuleIntegrations(),
 		},
 	}
 
@@ -356,6 +409,18 @@ func init() {
 			"While it is possible to enable this setting on github.com, " +
 			"github.com's best practices recommend using serialization to avoid hitting abuse rate limits" +
 			"Defaults to false if not set",
+		"rate_limit_reserve": "Number of requests to leave unused in each GitHub API rate limit for other automation " +
+			"sharing the token. Once only the reserve is left, requests wait until the rate limit resets and a warning is reported. " +
+			"Defaults to 0 if not set.",
+		"rate_limit_pacing": "Spread the requests over the time left until each GitHub API rate limit resets once less than half " +
+			"of its budget above `rate_limit_reserve` remains, instead of exhausting it and stalling. Defaults to true if not set.",
+		"response_cache": "Serve repeated identical read requests within a run from a cache and coalesce concurrent ones " +
+			"into a single request. Writes invalidate the cached reads they may affect. Defaults to true if not set.",
+		"batch_repository_reads": "Read existing `github_repository` resources in batches of up to 100 repositories per " +
+			"GraphQL query instead of several REST API requests each, which speeds up refreshing large numbers of repositories. " +
+			"The GraphQL API does not serve `security_and_analysis`, `has_downloads` and `pages`, so repositories with any of them " +
+			"in their state are still read through the REST API, and changes to them made outside of Terraform aren't detected for the " +
+			"others. Defaults to false if not set.",
 		"retryable_errors": "Allow the provider to retry after receiving an error status code, the max_retries should be set for this to work" +
 			"Defaults to [500, 502, 503, 504]",
 		"max_retries": "Number of times to retry a request after receiving an error status code" +
@@ -398,6 +463,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 			owner = org
 		}
 
+		var tokenSource oauth2.TokenSource
 		if appAuth, ok := d.Get("app_auth").([]any); ok && len(appAuth) > 0 && appAuth[0] != nil {
 			appAuthAttr := appAuth[0].(map[string]any)
 
@@ -433,15 +499,16 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 				apiPath = GHESRESTAPIPath
 			}
 
-			appToken, err := GenerateOAuthTokenFromApp(baseURL.JoinPath(apiPath), appID, appInstallationID, appPemFile)
-			if err != nil {
+			// The installation token is only valid for one hour, so the clients are backed by a token source
+			// that re-mints it before it expires. Fetching the first token here surfaces invalid credentials
+			// during configuration rather than on the first API call.
+			tokenSource = NewAppTokenSource(baseURL.JoinPath(apiPath), appID, appInstallationID, appPemFile)
+			if _, err := tokenSource.Token(); err != nil {
 				return nil, wrapErrors([]error{err})
 			}
-
-			token = appToken
 		}
 
-		if token == "" {
+		if token == "" && tokenSource == nil {
 			log.Printf("[INFO] No token found, using GitHub CLI to get token from hostname %s", baseURL.Host)
 			token = tokenFromGHCLI(baseURL)
 		}
@@ -494,18 +561,38 @@ func providerConfigure(p *schema.Provider) schema.ConfigureContextFunc {
 
 		log.Printf("[DEBUG] Setting parallel_requests to %t", parallelRequests)
 
+		rateLimitReserve := d.Get("rate_limit_reserve").(int)
+		if rateLimitReserve < 0 {
+			return nil, diag.FromErr(fmt.Errorf("rate_limit_reserve must be greater than or equal to 0"))
+		}
+		log.Printf("[DEBUG] Setting rate_limit_reserve to %d", rateLimitReserve)
+
+		rateLimitPacing := d.Get("rate_limit_pacing").(bool)
+		log.Printf("[DEBUG] Setting rate_limit_pacing to %t", rateLimitPacing)
+
+		responseCache := d.Get("response_cache").(bool)
+		log.Printf("[DEBUG] Setting response_cache to %t", responseCache)
+
+		batchRepositoryReads := d.Get("batch_repository_reads").(bool)
+		log.Printf("[DEBUG] Setting batch_repository_reads to %t", batchRepositoryReads)
+
 		config := Config{
-			Token:            token,
-			BaseURL:          baseURL,
-			Insecure:         insecure,
-			Owner:            owner,
-			WriteDelay:       time.Duration(writeDelay) * time.Millisecond,
-			ReadDelay:        time.Duration(readDelay) * time.Millisecond,
-			RetryDelay:       time.Duration(retryDelay) * time.Millisecond,
-			RetryableErrors:  retryableErrors,
-			MaxRetries:       maxRetries,
-			ParallelRequests: parallelRequests,
-			IsGHES:           isGHES,
+			Token:                token,
+			TokenSource:          tokenSource,
+			BaseURL:              baseURL,
+			Insecure:             insecure,
+			Owner:                owner,
+			WriteDelay:           time.Duration(writeDelay) * time.Millisecond,
+			ReadDelay:            time.Duration(readDelay) * time.Millisecond,
+			RetryDelay:           time.Duration(retryDelay) * time.Millisecond,
+			RetryableErrors:      retryableErrors,
+			MaxRetries:           maxRetries,
+			ParallelRequests:     parallelRequests,
+			RateLimitReserve:     rateLimitReserve,
+			RateLimitPacing:      rateLimitPacing,
+			DisableResponseCache: !responseCache,
+			IsGHES:               isGHES,
+			BatchRepositoryReads: batchRepositoryReads,
 		}
 
 		meta, err := config.Meta()
@@ -0,0 +1,282 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ProtoV5ProviderServerFactory returns a factory for a provider server that muxes the SDKv2 provider together
+// with the terraform-plugin-framework provider. Features which are only available in the plugin framework, such
+// as ephemeral resources and provider-defined functions, are served by the latter.
+func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	primary := Provider()
+
+	servers := []func() tfprotov5.ProviderServer{
+		func() tfprotov5.ProviderServer {
+			return &rateLimitWarningsServer{ProviderServer: primary.GRPCProvider(), primary: primary}
+		},
+		providerserver.NewProtocol5(NewFrameworkProvider(primary)),
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, servers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
+
+// rateLimitWarningsServer reports the warnings about reaching the rate_limit_reserve, which the transport records,
+// as warnings of the operation of the SDKv2 provider during which they were recorded.
+type rateLimitWarningsServer struct {
+	tfprotov5.ProviderServer
+	primary *sdkschema.Provider
+}
+
+func (s *rateLimitWarningsServer) warnings() []*tfprotov5.Diagnostic {
+	owner, ok := s.primary.Meta().(*Owner)
+	if !ok {
+		return nil
+	}
+
+	var diags []*tfprotov5.Diagnostic
+	for _, warning := range owner.rateLimits.warnings() {
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "GitHub API rate limit reserve reached",
+			Detail:   warning,
+		})
+	}
+	return diags
+}
+
+func (s *rateLimitWarningsServer) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(resp.Diagnostics, s.warnings()...)
+	}
+	return resp, err
+}
+
+func (s *rateLimitWarningsServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(resp.Diagnostics, s.warnings()...)
+	}
+	return resp, err
+}
+
+func (s *rateLimitWarningsServer) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(resp.Diagnostics, s.warnings()...)
+	}
+	return resp, err
+}
+
+func (s *rateLimitWarningsServer) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(resp.Diagnostics, s.warnings()...)
+	}
+	return resp, err
+}
+
+func (s *rateLimitWarningsServer) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		resp.Diagnostics = append(resp.Diagnostics, s.warnings()...)
+	}
+	return resp, err
+}
+
+// frameworkProvider is the terraform-plugin-framework half of the provider. It does not configure its own
+// clients; instead it shares the *Owner configured by the SDKv2 provider, which is always configured first
+// by the mux server.
+type frameworkProvider struct {
+	primary *sdkschema.Provider
+}
+
+var (
+	_ provider.Provider                       = &frameworkProvider{}
+	_ provider.ProviderWithEphemeralResources = &frameworkProvider{}
+	_ provider.ProviderWithFunctions          = &frameworkProvider{}
+)
+
+func NewFrameworkProvider(primary *sdkschema.Provider) provider.Provider {
+	return &frameworkProvider{primary: primary}
+}
+
+func (p *frameworkProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "github"
+}
+
+// Schema mirrors the SDKv2 provider schema, as the mux server requires both to be identical.
+func (p *frameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["token"],
+			},
+			"owner": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["owner"],
+			},
+			"retryable_errors": schema.ListAttribute{
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Description: descriptions["retryable_errors"],
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["max_retries"],
+			},
+			"organization": schema.StringAttribute{
+				Optional:           true,
+				Description:        descriptions["organization"],
+				DeprecationMessage: "Use owner (or GITHUB_OWNER) instead of organization (or GITHUB_ORGANIZATION)",
+			},
+			"base_url": schema.StringAttribute{
+				Optional:    true,
+				Description: descriptions["base_url"],
+			},
+			"insecure": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["insecure"],
+			},
+			"write_delay_ms": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["write_delay_ms"],
+			},
+			"read_delay_ms": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["read_delay_ms"],
+			},
+			"retry_delay_ms": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["retry_delay_ms"],
+			},
+			"parallel_requests": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["parallel_requests"],
+			},
+			"rate_limit_reserve": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["rate_limit_reserve"],
+			},
+			"rate_limit_pacing": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["rate_limit_pacing"],
+			},
+			"response_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["response_cache"],
+			},
+			"batch_repository_reads": schema.BoolAttribute{
+				Optional:    true,
+				Description: descriptions["batch_repository_reads"],
+			},
+			"max_per_page": schema.Int64Attribute{
+				Optional:    true,
+				Description: descriptions["max_per_page"],
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"app_auth": schema.ListNestedBlock{
+				Description: descriptions["app_auth"],
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						// The SDKv2 provider reports these as optional when their environment variable is set.
+						"id": schema.StringAttribute{
+							Required:    !envIsSet("GITHUB_APP_ID"),
+							Optional:    envIsSet("GITHUB_APP_ID"),
+							Description: descriptions["app_auth.id"],
+						},
+						"installation_id": schema.StringAttribute{
+							Required:    !envIsSet("GITHUB_APP_INSTALLATION_ID"),
+							Optional:    envIsSet("GITHUB_APP_INSTALLATION_ID"),
+							Description: descriptions["app_auth.installation_id"],
+						},
+						"pem_file": schema.StringAttribute{
+							Required:    !envIsSet("GITHUB_APP_PEM_FILE"),
+							Optional:    envIsSet("GITHUB_APP_PEM_FILE"),
+							Sensitive:   true,
+							Description: descriptions["app_auth.pem_file"],
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (p *frameworkProvider) Configure(_ context.Context, _ provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	meta := p.primary.Meta()
+
+	resp.DataSourceData = meta
+	resp.ResourceData = meta
+	resp.EphemeralResourceData = meta
+}
+
+func (p *frameworkProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+func (p *frameworkProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+func (p *frameworkProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewEphemeralGithubActionsRegistrationToken,
+		NewEphemeralGithubAppToken,
+		NewEphemeralGithubEncryptedSecret,
+	}
+}
+
+func (p *frameworkProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewBuildIDFunction,
+		NewParseIDFunction,
+		NewParseRepositoryFullNameFunction,
+	}
+}
+
+// envIsSet reports whether the schema.EnvDefaultFunc for the given environment variable would return a value.
+func envIsSet(key string) bool {
+	return os.Getenv(key) != ""
+}
+
+// frameworkProviderOwner extracts the *Owner shared by the SDKv2 provider from framework provider data.
+// It returns nil without diagnostics when the provider has not been configured yet.
+func frameworkProviderOwner(providerData any, diags *diag.Diagnostics) *Owner {
+	if providerData == nil {
+		return nil
+	}
+
+	owner, ok := providerData.(*Owner)
+	if !ok {
+		diags.AddError(
+			"Unexpected provider data type",
+			fmt.Sprintf("Expected *Owner, got: %T. Please report this issue to the provider developers.", providerData),
+		)
+		return nil
+	}
+
+	return owner
+}
@@ -1,10 +1,13 @@
 package github
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -26,6 +29,111 @@ func TestProvider(t *testing.T) {
 	})
 }
 
+func TestProtoV5ProviderServerFactory(t *testing.T) {
+	getProviderSchema := func(t *testing.T) *tfprotov5.GetProviderSchemaResponse {
+		t.Helper()
+
+		factory, err := ProtoV5ProviderServerFactory(t.Context())
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		resp, err := factory().GetProviderSchema(t.Context(), &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		for _, d := range resp.Diagnostics {
+			if d.Severity == tfprotov5.DiagnosticSeverityError {
+				t.Fatalf("unexpected error diagnostic: %s: %s", d.Summary, d.Detail)
+			}
+		}
+
+		return resp
+	}
+
+	t.Run("serves identical provider schemas from both servers", func(t *testing.T) {
+		t.Setenv("GITHUB_APP_ID", "")
+		t.Setenv("GITHUB_APP_INSTALLATION_ID", "")
+		t.Setenv("GITHUB_APP_PEM_FILE", "")
+
+		getProviderSchema(t)
+	})
+
+	t.Run("serves identical provider schemas when app_auth environment variables are set", func(t *testing.T) {
+		t.Setenv("GITHUB_APP_ID", "123456789")
+		t.Setenv("GITHUB_APP_INSTALLATION_ID", "987654321")
+		t.Setenv("GITHUB_APP_PEM_FILE", "pem")
+
+		getProviderSchema(t)
+	})
+
+	t.Run("serves ephemeral resources", func(t *testing.T) {
+		resp := getProviderSchema(t)
+
+		for _, name := range []string{"github_actions_registration_token", "github_app_token", "github_encrypted_secret"} {
+			if _, ok := resp.EphemeralResourceSchemas[name]; !ok {
+				t.Errorf("expected ephemeral resource %s to be served", name)
+			}
+		}
+	})
+
+	t.Run("serves provider functions", func(t *testing.T) {
+		resp := getProviderSchema(t)
+
+		for _, name := range []string{"build_id", "parse_id", "parse_repository_full_name"} {
+			if _, ok := resp.Functions[name]; !ok {
+				t.Errorf("expected function %s to be served", name)
+			}
+		}
+	})
+}
+
+func TestRateLimitWarningsServer(t *testing.T) {
+	budgets := newRateLimitBudgets(100, false)
+	budgets.buckets["core"] = rateLimitBudget{limit: 5000, remaining: 50, reset: time.Now().Add(time.Hour)}
+	budgets.pace("core", time.Now())
+
+	primary := Provider()
+	primary.SetMeta(&Owner{rateLimits: budgets})
+	server := &rateLimitWarningsServer{ProviderServer: readDataSourceServer{}, primary: primary}
+
+	warnings := func(diags []*tfprotov5.Diagnostic) int {
+		count := 0
+		for _, d := range diags {
+			if d.Severity == tfprotov5.DiagnosticSeverityWarning && d.Summary == "GitHub API rate limit reserve reached" {
+				count++
+			}
+		}
+		return count
+	}
+
+	resp, err := server.ReadDataSource(t.Context(), &tfprotov5.ReadDataSourceRequest{TypeName: "github_unknown"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got := warnings(resp.Diagnostics); got != 1 {
+		t.Errorf("expected the warning to be reported, got %d warnings in %v", got, resp.Diagnostics)
+	}
+
+	resp, err = server.ReadDataSource(t.Context(), &tfprotov5.ReadDataSourceRequest{TypeName: "github_unknown"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if got := warnings(resp.Diagnostics); got != 0 {
+		t.Errorf("expected the warning to be reported once, got %d warnings", got)
+	}
+}
+
+// readDataSourceServer is a tfprotov5.ProviderServer which only reads data sources, without diagnostics.
+type readDataSourceServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (readDataSourceServer) ReadDataSource(context.Context, *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	return &tfprotov5.ReadDataSourceResponse{}, nil
+}
+
 func TestAccProviderConfigure(t *testing.T) {
 	t.Run("can_be_configured_to_run_anonymously", func(t *testing.T) {
 		config := `
@@ -55,21 +55,21 @@ func resourceGithubActionsEnvironmentSecret() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				RequiredWith:  []string{"value_encrypted"},
-				ConflictsWith: []string{"value", "plaintext_value"},
+				ConflictsWith: []string{"value", "plaintext_value", "value_wo"},
 				Description:   "ID of the public key used to encrypt the secret.",
 			},
 			"value": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Sensitive:    true,
-				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				Description:  "Plaintext value to be encrypted.",
 			},
 			"value_encrypted": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Sensitive:        true,
-				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
 				Description:      "Value encrypted with the GitHub public key, defined by key_id, in Base64 format.",
 			},
@@ -77,7 +77,7 @@ func resourceGithubActionsEnvironmentSecret() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Sensitive:        true,
-				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
 				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
 				Deprecated:       "Use value_encrypted and key_id.",
@@ -86,10 +86,24 @@ func resourceGithubActionsEnvironmentSecret() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Sensitive:    true,
-				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				Description:  "Plaintext value of the secret to be encrypted.",
 				Deprecated:   "Use value.",
 			},
+			"value_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
+				Description:  "Plaintext value to be encrypted. This value is write-only and is never stored in the plan or state.",
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
+				Description:  "Version of the write-only value. Changing it triggers an update of the secret with the current value_wo.",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -153,7 +167,10 @@ func resourceGithubActionsEnvironmentSecretCreate(ctx context.Context, d *schema
 	}
 
 	if len(encryptedValue) == 0 {
-		plaintextValue, _ := resourceKeysGetOk[string](d, "value", "plaintext_value")
+		plaintextValue, err := getSecretPlaintextValue(d, "value", "plaintext_value")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		encryptedBytes, err := encryptPlaintext(plaintextValue, publicKey)
 		if err != nil {
@@ -274,7 +291,10 @@ func resourceGithubActionsEnvironmentSecretUpdate(ctx context.Context, d *schema
 	}
 
 	if len(encryptedValue) == 0 {
-		plaintextValue, _ := resourceKeysGetOk[string](d, "value", "plaintext_value")
+		plaintextValue, err := getSecretPlaintextValue(d, "value", "plaintext_value")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		encryptedBytes, err := encryptPlaintext(plaintextValue, publicKey)
 		if err != nil {
@@ -533,6 +533,8 @@ func resourceGithubActionsHostedRunnerDelete(d *schema.ResourceData, meta any) e
 }
 
 func waitForRunnerDeletion(ctx context.Context, client *github.Client, orgName, runnerID string, timeout time.Duration) error {
+	// Poll the runner itself rather than the response cache, which would keep serving its state before the deletion.
+	ctx = context.WithValue(ctx, ctxSkipCache, true)
 	conf := &retry.StateChangeConf{
 		Pending: []string{"deleting", "active"},
 		Target:  []string{"deleted"},
@@ -38,21 +38,21 @@ func resourceGithubActionsOrganizationSecret() *schema.Resource {
 				Optional:      true,
 				Computed:      true,
 				RequiredWith:  []string{"value_encrypted"},
-				ConflictsWith: []string{"value", "plaintext_value"},
+				ConflictsWith: []string{"value", "plaintext_value", "value_wo"},
 				Description:   "ID of the public key used to encrypt the secret.",
 			},
 			"value": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Sensitive:    true,
-				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				Description:  "Plaintext value to be encrypted.",
 			},
 			"value_encrypted": {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Sensitive:        true,
-				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
 				Description:      "Value encrypted with the GitHub public key, defined by key_id, in Base64 format.",
 			},
@@ -60,7 +60,7 @@ func resourceGithubActionsOrganizationSecret() *schema.Resource {
 				Type:             schema.TypeString,
 				Optional:         true,
 				Sensitive:        true,
-				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf:     []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
 				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
 				Deprecated:       "Use value_encrypted and key_id.",
@@ -69,10 +69,24 @@ func resourceGithubActionsOrganizationSecret() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Sensitive:    true,
-				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value"},
+				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
 				Description:  "Plaintext value of the secret to be encrypted.",
 				Deprecated:   "Use value.",
 			},
+			"value_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{"value", "value_encrypted", "encrypted_value", "plaintext_value", "value_wo"},
+				Description:  "Plaintext value to be encrypted. This value is write-only and is never stored in the plan or state.",
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
+				Description:  "Version of the write-only value. Changing it triggers an update of the secret with the current value_wo.",
+			},
 			"visibility": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -157,7 +171,10 @@ func resourceGithubActionsOrganizationSecretCreate(ctx context.Context, d *schem
 	}
 
 	if len(encryptedValue) == 0 {
-		plaintextValue, _ := resourceKeysGetOk[string](d, "value", "plaintext_value")
+		plaintextValue, err := getSecretPlaintextValue(d, "value", "plaintext_value")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		encryptedBytes, err := encryptPlaintext(plaintextValue, publicKey)
 		if err != nil {
@@ -306,7 +323,10 @@ func resourceGithubActionsOrganizationSecretUpdate(ctx context.Context, d *schem
 	}
 
 	if len(encryptedValue) == 0 {
-		plaintextValue, _ := resourceKeysGetOk[string](d, "value", "plaintext_value")
+		plaintextValue, err := getSecretPlaintextValue(d, "value", "plaintext_value")
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
 		encryptedBytes, err := encryptPlaintext(plaintextValue, publicKey)
 		if err != nil {
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccGithubActionsOrganizationSecret(t *testing.T) {
@@ -62,6 +63,50 @@ resource "github_actions_organization_secret" "test" {
 		})
 	})
 
+	t.Run("create_update_write_only", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
+		secretName := fmt.Sprintf("test_%s", randomID)
+		value := base64.StdEncoding.EncodeToString([]byte("foo"))
+		valueUpdated := base64.StdEncoding.EncodeToString([]byte("bar"))
+
+		config := `
+resource "github_actions_organization_secret" "test" {
+	secret_name      = "%s"
+	value_wo         = "%s"
+	value_wo_version = %d
+	visibility       = "all"
+}
+`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { skipUnlessHasOrgs(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, secretName, value, 1),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_actions_organization_secret.test", "secret_name", secretName),
+						resource.TestCheckResourceAttrSet("github_actions_organization_secret.test", "key_id"),
+						resource.TestCheckNoResourceAttr("github_actions_organization_secret.test", "value"),
+						resource.TestCheckNoResourceAttr("github_actions_organization_secret.test", "value_wo"),
+						resource.TestCheckResourceAttr("github_actions_organization_secret.test", "value_wo_version", "1"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, secretName, valueUpdated, 2),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr("github_actions_organization_secret.test", "value_wo"),
+						resource.TestCheckResourceAttr("github_actions_organization_secret.test", "value_wo_version", "2"),
+						resource.TestCheckResourceAttrSet("github_actions_organization_secret.test", "updated_at"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("create_update_encrypted", func(t *testing.T) {
 		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlpha)
 		secretName := fmt.Sprintf("test_%s", randomID)
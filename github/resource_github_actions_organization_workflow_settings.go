@@ -0,0 +1,110 @@
+package github
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubActionsOrganizationWorkflowSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the artifact and log retention and the fork pull request workflow policies of GitHub Actions in an organization.",
+
+		CreateContext: resourceGithubActionsOrganizationWorkflowSettingsCreate,
+		ReadContext:   resourceGithubActionsOrganizationWorkflowSettingsRead,
+		UpdateContext: resourceGithubActionsOrganizationWorkflowSettingsUpdate,
+		DeleteContext: resourceGithubActionsOrganizationWorkflowSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceGithubActionsOrganizationWorkflowSettingsDiff,
+
+		Schema: actionsWorkflowSettingsSchema("organization"),
+	}
+}
+
+func resourceGithubActionsOrganizationWorkflowSettingsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	if err = updateActionsWorkflowSettings(ctx, d, organizationActionsWorkflowSettingsAPI(client, orgName), false); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(orgName)
+
+	return resourceGithubActionsOrganizationWorkflowSettingsRead(ctx, d, meta)
+}
+
+func resourceGithubActionsOrganizationWorkflowSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	if err = readActionsWorkflowSettings(ctx, d, organizationActionsWorkflowSettingsAPI(client, orgName)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubActionsOrganizationWorkflowSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	if err = updateActionsWorkflowSettings(ctx, d, organizationActionsWorkflowSettingsAPI(client, orgName), true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubActionsOrganizationWorkflowSettingsRead(ctx, d, meta)
+}
+
+func resourceGithubActionsOrganizationWorkflowSettingsDelete(ctx context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	if err = resetActionsWorkflowSettings(ctx, organizationActionsWorkflowSettingsAPI(client, orgName), defaultArtifactAndLogRetentionDays); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGithubActionsOrganizationWorkflowSettingsDiff validates the retention against the maximum retention allowed
+// by the enterprise of the organization.
+func resourceGithubActionsOrganizationWorkflowSettingsDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if checkOrganization(meta) != nil {
+		return nil
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	return diffArtifactAndLogRetentionDays(ctx, d, func(ctx context.Context) (int, error) {
+		retention, _, err := client.Actions.GetArtifactAndLogRetentionPeriodInOrganization(ctx, orgName)
+		if err != nil {
+			return 0, err
+		}
+		return retention.GetMaximumAllowedDays(), nil
+	})
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubActionsOrganizationWorkflowSettings(t *testing.T) {
+	t.Run("manages the Actions workflow settings of an organization", func(t *testing.T) {
+		config := `
+			resource "github_actions_organization_workflow_settings" "test" {
+				artifact_and_log_retention_days = %d
+				fork_pr_approval_policy         = "%s"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, 60, "first_time_contributors"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_actions_organization_workflow_settings.test", "artifact_and_log_retention_days", "60"),
+						resource.TestCheckResourceAttrSet("github_actions_organization_workflow_settings.test", "maximum_artifact_and_log_retention_days"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, 30, "all_external_contributors"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_actions_organization_workflow_settings.test", "artifact_and_log_retention_days", "30"),
+						resource.TestCheckResourceAttr("github_actions_organization_workflow_settings.test", "fork_pr_approval_policy", "all_external_contributors"),
+					),
+				},
+				{
+					ResourceName:      "github_actions_organization_workflow_settings.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,140 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubActionsRepositoryWorkflowSettings() *schema.Resource {
+	s := actionsWorkflowSettingsSchema("repository")
+	s["repository"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Required:         true,
+		ForceNew:         true,
+		Description:      "The GitHub repository.",
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 100)),
+	}
+
+	return &schema.Resource{
+		Description: "Manage the artifact and log retention and the fork pull request workflow policies of GitHub Actions in a repository.",
+
+		CreateContext: resourceGithubActionsRepositoryWorkflowSettingsCreate,
+		ReadContext:   resourceGithubActionsRepositoryWorkflowSettingsRead,
+		UpdateContext: resourceGithubActionsRepositoryWorkflowSettingsUpdate,
+		DeleteContext: resourceGithubActionsRepositoryWorkflowSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubActionsRepositoryWorkflowSettingsImport,
+		},
+
+		CustomizeDiff: resourceGithubActionsRepositoryWorkflowSettingsDiff,
+
+		Schema: s,
+	}
+}
+
+func resourceGithubActionsRepositoryWorkflowSettingsCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	if err := updateActionsWorkflowSettings(ctx, d, repositoryActionsWorkflowSettingsAPI(client, owner, repoName), false); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(repoName)
+
+	return resourceGithubActionsRepositoryWorkflowSettingsRead(ctx, d, meta)
+}
+
+func resourceGithubActionsRepositoryWorkflowSettingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	err := readActionsWorkflowSettings(ctx, d, repositoryActionsWorkflowSettingsAPI(client, owner, repoName))
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing Actions workflow settings of repository %s/%s from state because it no longer exists in GitHub", owner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubActionsRepositoryWorkflowSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	if err := updateActionsWorkflowSettings(ctx, d, repositoryActionsWorkflowSettingsAPI(client, owner, repoName), true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubActionsRepositoryWorkflowSettingsRead(ctx, d, meta)
+}
+
+func resourceGithubActionsRepositoryWorkflowSettingsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	// Repositories retain artifacts and logs for as long as their owner allows by default.
+	err := resetActionsWorkflowSettings(ctx, repositoryActionsWorkflowSettingsAPI(client, owner, repoName), 0)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(handleArchivedRepoDelete(err, "Actions workflow settings", repoName, owner, repoName))
+	}
+
+	return nil
+}
+
+func resourceGithubActionsRepositoryWorkflowSettingsImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := d.Set("repository", d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceGithubActionsRepositoryWorkflowSettingsDiff validates the retention against the maximum retention allowed by
+// the owner of the repository. The retention of an organization is the maximum retention of its repositories, which
+// is used for repositories which don't exist yet.
+func resourceGithubActionsRepositoryWorkflowSettingsDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("repository") {
+		return nil
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	return diffArtifactAndLogRetentionDays(ctx, d, func(ctx context.Context) (int, error) {
+		retention, _, err := client.Repositories.GetArtifactAndLogRetentionPeriod(ctx, owner, repoName)
+		if err == nil {
+			return retention.GetMaximumAllowedDays(), nil
+		}
+
+		var ghErr *github.ErrorResponse
+		if !meta.(*Owner).IsOrganization || !errors.As(err, &ghErr) || ghErr.Response.StatusCode != http.StatusNotFound {
+			return 0, err
+		}
+		retention, _, err = client.Actions.GetArtifactAndLogRetentionPeriodInOrganization(ctx, owner)
+		if err != nil {
+			return 0, err
+		}
+		return retention.GetDays(), nil
+	})
+}
@@ -0,0 +1,166 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func newActionsWorkflowSettingsTestMeta(t *testing.T, requests map[string]map[string]any) *Owner {
+	t.Helper()
+
+	record := func(key string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			requests[key] = body
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"days": 45, "maximum_allowed_days": 90}`)
+	})
+	mux.HandleFunc("GET /repos/test-org/private/actions/permissions/artifact-and-log-retention", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"days": 14, "maximum_allowed_days": 45}`)
+	})
+	mux.HandleFunc("PUT /repos/test-org/private/actions/permissions/artifact-and-log-retention", record("retention"))
+	mux.HandleFunc("GET /repos/test-org/private/actions/permissions/fork-pr-contributor-approval", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		mustWrite(w, `{"message": "Only available for public repositories."}`)
+	})
+	mux.HandleFunc("PUT /repos/test-org/private/actions/permissions/fork-pr-contributor-approval", record("approval"))
+	mux.HandleFunc("GET /repos/test-org/private/actions/permissions/fork-pr-workflows-private-repos", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"run_workflows_from_fork_pull_requests": true, "send_write_tokens_to_workflows": false, "send_secrets_and_variables": false, "require_approval_for_fork_pr_workflows": true}`)
+	})
+	mux.HandleFunc("PUT /repos/test-org/private/actions/permissions/fork-pr-workflows-private-repos", record("private_fork"))
+
+	return newTestOwner(t, mux, "test-org", true)
+}
+
+func TestGithubActionsRepositoryWorkflowSettingsCreate(t *testing.T) {
+	requests := make(map[string]map[string]any)
+	meta := newActionsWorkflowSettingsTestMeta(t, requests)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubActionsRepositoryWorkflowSettings().Schema, map[string]any{
+		"repository":                      "private",
+		"artifact_and_log_retention_days": 14,
+		"private_fork_pr_workflows": []any{
+			map[string]any{"run_workflows": true, "require_approval": true},
+		},
+	})
+
+	if diags := resourceGithubActionsRepositoryWorkflowSettingsCreate(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := fmt.Sprint(requests["retention"]); got != "map[days:14]" {
+		t.Errorf("got retention request %s", got)
+	}
+	if _, ok := requests["approval"]; ok {
+		t.Error("expected the unconfigured approval policy not to be updated")
+	}
+	if got := fmt.Sprint(requests["private_fork"]); got != "map[require_approval_for_fork_pr_workflows:true run_workflows_from_fork_pull_requests:true send_secrets_and_variables:false send_write_tokens_to_workflows:false]" {
+		t.Errorf("got private fork request %s", got)
+	}
+
+	for k, want := range map[string]any{
+		"artifact_and_log_retention_days":              14,
+		"maximum_artifact_and_log_retention_days":      45,
+		"fork_pr_approval_policy":                      "",
+		"private_fork_pr_workflows.0.run_workflows":    true,
+		"private_fork_pr_workflows.0.require_approval": true,
+	} {
+		if got := d.Get(k); got != want {
+			t.Errorf("got %s %v; want %v", k, got, want)
+		}
+	}
+}
+
+func TestGithubActionsRepositoryWorkflowSettingsDiff(t *testing.T) {
+	meta := newActionsWorkflowSettingsTestMeta(t, make(map[string]map[string]any))
+
+	for _, tc := range []struct {
+		repository string
+		days       int
+		wantErr    string
+	}{
+		{"private", 30, ""},
+		{"private", 60, "exceeds the maximum retention of 45 days"},
+		// Repositories which don't exist yet are validated against the retention of the organization.
+		{"new", 45, ""},
+		{"new", 60, "exceeds the maximum retention of 45 days"},
+	} {
+		t.Run(fmt.Sprintf("%s %d", tc.repository, tc.days), func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				"repository":                      tc.repository,
+				"artifact_and_log_retention_days": tc.days,
+			})
+			_, err := resourceGithubActionsRepositoryWorkflowSettings().Diff(t.Context(), nil, config, meta)
+			if tc.wantErr == "" && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tc.wantErr)) {
+				t.Fatalf("got error %v; want %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccGithubActionsRepositoryWorkflowSettings(t *testing.T) {
+	t.Run("manages the Actions workflow settings of a repository", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_repository" "test" {
+				name       = "%[1]sworkflow-settings-%[2]s"
+				visibility = "public"
+			}
+
+			resource "github_actions_repository_workflow_settings" "test" {
+				repository                      = github_repository.test.name
+				artifact_and_log_retention_days = %[3]d
+				fork_pr_approval_policy         = "%[4]s"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, 30, "first_time_contributors"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_actions_repository_workflow_settings.test", "artifact_and_log_retention_days", "30"),
+						resource.TestCheckResourceAttr("github_actions_repository_workflow_settings.test", "fork_pr_approval_policy", "first_time_contributors"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, 7, "all_external_contributors"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_actions_repository_workflow_settings.test", "artifact_and_log_retention_days", "7"),
+						resource.TestCheckResourceAttr("github_actions_repository_workflow_settings.test", "fork_pr_approval_policy", "all_external_contributors"),
+					),
+				},
+				{
+					ResourceName:      "github_actions_repository_workflow_settings.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccGithubActionsSecret(t *testing.T) {
@@ -102,6 +103,56 @@ resource "github_actions_secret" "test" {
 		})
 	})
 
+	t.Run("create_update_write_only", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("%s%s", testResourcePrefix, randomID)
+		secretName := "test"
+		value := base64.StdEncoding.EncodeToString([]byte("super_secret_value"))
+		updatedValue := base64.StdEncoding.EncodeToString([]byte("updated_super_secret_value"))
+
+		config := `
+resource "github_repository" "test" {
+	name = "%s"
+}
+
+resource "github_actions_secret" "test" {
+	repository       = github_repository.test.name
+	secret_name      = "%s"
+	value_wo         = "%s"
+	value_wo_version = %d
+}
+`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { skipUnauthenticated(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, repoName, secretName, value, 1),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_actions_secret.test", "secret_name", secretName),
+						resource.TestCheckNoResourceAttr("github_actions_secret.test", "value"),
+						resource.TestCheckNoResourceAttr("github_actions_secret.test", "value_wo"),
+						resource.TestCheckResourceAttr("github_actions_secret.test", "value_wo_version", "1"),
+						resource.TestCheckResourceAttrSet("github_actions_secret.test", "key_id"),
+						resource.TestCheckResourceAttrSet("github_actions_secret.test", "updated_at"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, repoName, secretName, updatedValue, 2),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckNoResourceAttr("github_actions_secret.test", "value_wo"),
+						resource.TestCheckResourceAttr("github_actions_secret.test", "value_wo_version", "2"),
+						resource.TestCheckResourceAttrSet("github_actions_secret.test", "updated_at"),
+					),
+				},
+			},
+		})
+	})
+
 	t.Run("create_update_encrypted", func(t *testing.T) {
 		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
 		repoName := fmt.Sprintf("%s%s", testResourcePrefix, randomID)
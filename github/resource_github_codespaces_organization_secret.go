@@ -40,7 +40,7 @@ func resourceGithubCodespacesOrganizationSecret() *schema.Resource {
 				ForceNew:         true,
 				Optional:         true,
 				Sensitive:        true,
-				ConflictsWith:    []string{"plaintext_value"},
+				ConflictsWith:    []string{"plaintext_value", "value_wo"},
 				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
 			},
@@ -50,7 +50,22 @@ func resourceGithubCodespacesOrganizationSecret() *schema.Resource {
 				Optional:      true,
 				Sensitive:     true,
 				Description:   "Plaintext value of the secret to be encrypted.",
-				ConflictsWith: []string{"encrypted_value"},
+				ConflictsWith: []string{"encrypted_value", "value_wo"},
+			},
+			"value_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"encrypted_value", "plaintext_value"},
+				Description:   "Plaintext value of the secret to be encrypted. This value is write-only and is never stored in the plan or state.",
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				ForceNew:     true,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
+				Description:  "Version of the write-only value. Changing it recreates the secret with the current value_wo.",
 			},
 			"visibility": {
 				Type:             schema.TypeString,
@@ -89,7 +104,10 @@ func resourceGithubCodespacesOrganizationSecretCreateOrUpdate(d *schema.Resource
 	ctx := context.Background()
 
 	secretName := d.Get("secret_name").(string)
-	plaintextValue := d.Get("plaintext_value").(string)
+	plaintextValue, err := getSecretPlaintextValue(d, "plaintext_value")
+	if err != nil {
+		return err
+	}
 	var encryptedValue string
 
 	visibility := d.Get("visibility").(string)
@@ -41,7 +41,7 @@ func resourceGithubCodespacesSecret() *schema.Resource {
 				ForceNew:      true,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"plaintext_value"},
+				ConflictsWith: []string{"plaintext_value", "value_wo"},
 				Description:   "Encrypted value of the secret using the GitHub public key in Base64 format.",
 			},
 			"plaintext_value": {
@@ -49,9 +49,24 @@ func resourceGithubCodespacesSecret() *schema.Resource {
 				ForceNew:      true,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"encrypted_value"},
+				ConflictsWith: []string{"encrypted_value", "value_wo"},
 				Description:   "Plaintext value of the secret to be encrypted.",
 			},
+			"value_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"encrypted_value", "plaintext_value"},
+				Description:   "Plaintext value of the secret to be encrypted. This value is write-only and is never stored in the plan or state.",
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				ForceNew:     true,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
+				Description:  "Version of the write-only value. Changing it recreates the secret with the current value_wo.",
+			},
 			"created_at": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -73,7 +88,10 @@ func resourceGithubCodespacesSecretCreateOrUpdate(d *schema.ResourceData, meta a
 
 	repo := d.Get("repository").(string)
 	secretName := d.Get("secret_name").(string)
-	plaintextValue := d.Get("plaintext_value").(string)
+	plaintextValue, err := getSecretPlaintextValue(d, "plaintext_value")
+	if err != nil {
+		return err
+	}
 	var encryptedValue string
 
 	keyId, publicKey, err := getCodespacesPublicKeyDetails(owner, repo, meta)
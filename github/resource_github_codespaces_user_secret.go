@@ -39,7 +39,7 @@ func resourceGithubCodespacesUserSecret() *schema.Resource {
 				ForceNew:         true,
 				Optional:         true,
 				Sensitive:        true,
-				ConflictsWith:    []string{"plaintext_value"},
+				ConflictsWith:    []string{"plaintext_value", "value_wo"},
 				Description:      "Encrypted value of the secret using the GitHub public key in Base64 format.",
 				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsBase64),
 			},
@@ -49,7 +49,22 @@ func resourceGithubCodespacesUserSecret() *schema.Resource {
 				Optional:      true,
 				Sensitive:     true,
 				Description:   "Plaintext value of the secret to be encrypted.",
-				ConflictsWith: []string{"encrypted_value"},
+				ConflictsWith: []string{"encrypted_value", "value_wo"},
+			},
+			"value_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"encrypted_value", "plaintext_value"},
+				Description:   "Plaintext value of the secret to be encrypted. This value is write-only and is never stored in the plan or state.",
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				ForceNew:     true,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
+				Description:  "Version of the write-only value. Changing it recreates the secret with the current value_wo.",
 			},
 			"selected_repository_ids": {
 				Type: schema.TypeSet,
@@ -80,7 +95,10 @@ func resourceGithubCodespacesUserSecretCreateOrUpdate(d *schema.ResourceData, me
 	ctx := context.Background()
 
 	secretName := d.Get("secret_name").(string)
-	plaintextValue := d.Get("plaintext_value").(string)
+	plaintextValue, err := getSecretPlaintextValue(d, "plaintext_value")
+	if err != nil {
+		return err
+	}
 	var encryptedValue string
 
 	selectedRepositories, hasSelectedRepositories := d.GetOk("selected_repository_ids")
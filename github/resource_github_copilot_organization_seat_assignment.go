@@ -0,0 +1,153 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubCopilotOrganizationSeatAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Assign a Copilot seat of an organization to a team or a user.",
+
+		CreateContext: resourceGithubCopilotOrganizationSeatAssignmentCreate,
+		ReadContext:   resourceGithubCopilotOrganizationSeatAssignmentRead,
+		DeleteContext: resourceGithubCopilotOrganizationSeatAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubCopilotOrganizationSeatAssignmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"team": {
+				Description:  "The slug of the team to assign Copilot seats to. Each member of the team is assigned a seat.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"team", "user"},
+			},
+			"user": {
+				Description:      "The login of the user to assign a Copilot seat to.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: caseInsensitive(),
+				ExactlyOneOf:     []string{"team", "user"},
+			},
+		},
+	}
+}
+
+func resourceGithubCopilotOrganizationSeatAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	var id string
+	if team, ok := d.GetOk("team"); ok {
+		_, _, err = client.Copilot.AddCopilotTeams(ctx, orgName, []string{team.(string)})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error assigning Copilot seats to team %s/%s: %w", orgName, team, err))
+		}
+		id, err = buildID("team", team.(string))
+	} else {
+		user := d.Get("user").(string)
+		_, _, err = client.Copilot.AddCopilotUsers(ctx, orgName, []string{user})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error assigning a Copilot seat to user %s in %s: %w", user, orgName, err))
+		}
+		id, err = buildID("user", user)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return resourceGithubCopilotOrganizationSeatAssignmentRead(ctx, d, meta)
+}
+
+func resourceGithubCopilotOrganizationSeatAssignmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	assigned := true
+	if team, ok := d.GetOk("team"); ok {
+		seats, err := listCopilotSeats(ctx, client, orgName)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		teams, _ := copilotSeatAssignments(seats)
+		if err = addCopilotTeamsWithoutMembers(ctx, client, orgName, teams, []string{team.(string)}); err != nil {
+			return diag.FromErr(err)
+		}
+		_, assigned = teams[strings.ToLower(team.(string))]
+	} else {
+		seat, _, err := client.Copilot.GetSeatDetails(ctx, orgName, d.Get("user").(string))
+		if err != nil {
+			var ghErr *github.ErrorResponse
+			if !errors.As(err, &ghErr) || ghErr.Response.StatusCode != http.StatusNotFound {
+				return diag.FromErr(err)
+			}
+			assigned = false
+		} else {
+			assigned = seat.GetPendingCancellationDate() == ""
+		}
+	}
+
+	if !assigned {
+		log.Printf("[INFO] Removing Copilot seat assignment %s/%s from state because it no longer exists in GitHub", orgName, d.Id())
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceGithubCopilotOrganizationSeatAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	// Seats are cancelled at the end of the billing cycle.
+	if team, ok := d.GetOk("team"); ok {
+		_, _, err = client.Copilot.RemoveCopilotTeams(ctx, orgName, []string{team.(string)})
+	} else {
+		_, _, err = client.Copilot.RemoveCopilotUsers(ctx, orgName, []string{d.Get("user").(string)})
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error cancelling Copilot seat assignment %s/%s: %w", orgName, d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceGithubCopilotOrganizationSeatAssignmentImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	kind, name, err := parseID2(d.Id())
+	if err != nil || (kind != "team" && kind != "user") {
+		return nil, fmt.Errorf("invalid id (%s), expected format team:<team_slug> or user:<login>", d.Id())
+	}
+
+	if err := d.Set(kind, name); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,83 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubCopilotOrganizationSeatAssignmentRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/copilot/billing/seats", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, testCopilotSeats)
+	})
+	mux.HandleFunc("GET /orgs/test-org/members/octocat/copilot", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"assignee": {"login": "octocat", "type": "User"}}`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/members/leaver/copilot", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"assignee": {"login": "leaver", "type": "User"}, "pending_cancellation_date": "2026-02-01"}`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/members/ghost/copilot", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	for _, tc := range []struct {
+		kind, name string
+		assigned   bool
+	}{
+		{"user", "octocat", true},
+		{"user", "leaver", false},
+		{"user", "ghost", false},
+		{"team", "bots", true},
+		{"team", "Bots", true},
+		{"team", "designers", false},
+	} {
+		t.Run(fmt.Sprintf("%s %s", tc.kind, tc.name), func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceGithubCopilotOrganizationSeatAssignment().Schema, map[string]any{tc.kind: tc.name})
+			d.SetId(tc.kind + ":" + tc.name)
+
+			if diags := resourceGithubCopilotOrganizationSeatAssignmentRead(t.Context(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if assigned := d.Id() != ""; assigned != tc.assigned {
+				t.Errorf("got assigned %t; want %t", assigned, tc.assigned)
+			}
+		})
+	}
+}
+
+func TestAccGithubCopilotOrganizationSeatAssignment(t *testing.T) {
+	t.Run("assigns a Copilot seat to a user", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_copilot_organization_seat_assignment" "test" {
+				user = "%s"
+			}
+		`, testAccConf.testOrgUser)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasPaidOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_copilot_organization_seat_assignment.test", "id", "user:"+testAccConf.testOrgUser),
+					),
+				},
+				{
+					ResourceName:      "github_copilot_organization_seat_assignment.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,189 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubCopilotOrganizationSeatAssignments() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the teams and users assigned Copilot seats of an organization. Seats assigned outside of Terraform are cancelled.",
+
+		CreateContext: resourceGithubCopilotOrganizationSeatAssignmentsCreateOrUpdate,
+		ReadContext:   resourceGithubCopilotOrganizationSeatAssignmentsRead,
+		UpdateContext: resourceGithubCopilotOrganizationSeatAssignmentsCreateOrUpdate,
+		DeleteContext: resourceGithubCopilotOrganizationSeatAssignmentsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"teams": {
+				Description: "The slugs of the teams to assign Copilot seats to. Each member of the teams is assigned a seat.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Description: "The logins of the users to assign a Copilot seat to directly.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceGithubCopilotOrganizationSeatAssignmentsCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	// The seats are listed from GitHub, so that seats assigned outside of Terraform are cancelled on creation too. Users
+	// are assigned a seat directly even if they have one through a team, which would be cancelled along with the team.
+	seats, err := listCopilotSeats(ctx, client, orgName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	teams, directUsers := copilotSeatAssignments(seats)
+	configuredTeams := expandStringList(d.Get("teams").(*schema.Set).List())
+	if err = addCopilotTeamsWithoutMembers(ctx, client, orgName, teams, configuredTeams); err != nil {
+		return diag.FromErr(err)
+	}
+
+	addTeams, removeTeams := diffCopilotSeatAssignments(teams, configuredTeams)
+	addUsers, removeUsers := diffCopilotSeatAssignments(directUsers, expandStringList(d.Get("users").(*schema.Set).List()))
+
+	if len(removeTeams) > 0 {
+		log.Printf("[DEBUG] Cancelling Copilot seats of teams %v in %s", removeTeams, orgName)
+		if _, _, err = client.Copilot.RemoveCopilotTeams(ctx, orgName, removeTeams); err != nil {
+			return diag.FromErr(fmt.Errorf("error cancelling Copilot seats of teams in %s: %w", orgName, err))
+		}
+	}
+	if len(removeUsers) > 0 {
+		log.Printf("[DEBUG] Cancelling Copilot seats of users %v in %s", removeUsers, orgName)
+		if _, _, err = client.Copilot.RemoveCopilotUsers(ctx, orgName, removeUsers); err != nil {
+			return diag.FromErr(fmt.Errorf("error cancelling Copilot seats of users in %s: %w", orgName, err))
+		}
+	}
+	if len(addTeams) > 0 {
+		log.Printf("[DEBUG] Assigning Copilot seats to teams %v in %s", addTeams, orgName)
+		if _, _, err = client.Copilot.AddCopilotTeams(ctx, orgName, addTeams); err != nil {
+			return diag.FromErr(fmt.Errorf("error assigning Copilot seats to teams in %s: %w", orgName, err))
+		}
+	}
+	if len(addUsers) > 0 {
+		log.Printf("[DEBUG] Assigning Copilot seats to users %v in %s", addUsers, orgName)
+		if _, _, err = client.Copilot.AddCopilotUsers(ctx, orgName, addUsers); err != nil {
+			return diag.FromErr(fmt.Errorf("error assigning Copilot seats to users in %s: %w", orgName, err))
+		}
+	}
+
+	d.SetId(orgName)
+
+	return resourceGithubCopilotOrganizationSeatAssignmentsRead(ctx, d, meta)
+}
+
+func resourceGithubCopilotOrganizationSeatAssignmentsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	seats, err := listCopilotSeats(ctx, client, orgName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	teams, directUsers := copilotSeatAssignments(seats)
+	if err = addCopilotTeamsWithoutMembers(ctx, client, orgName, teams, expandStringList(d.Get("teams").(*schema.Set).List())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("teams", flattenCopilotSeatAssignments(teams, d.Get("teams").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("users", flattenCopilotSeatAssignments(directUsers, d.Get("users").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubCopilotOrganizationSeatAssignmentsDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	if teams := expandStringList(d.Get("teams").(*schema.Set).List()); len(teams) > 0 {
+		if _, _, err = client.Copilot.RemoveCopilotTeams(ctx, orgName, teams); err != nil {
+			return diag.FromErr(fmt.Errorf("error cancelling Copilot seats of teams in %s: %w", orgName, err))
+		}
+	}
+	if users := expandStringList(d.Get("users").(*schema.Set).List()); len(users) > 0 {
+		if _, _, err = client.Copilot.RemoveCopilotUsers(ctx, orgName, users); err != nil {
+			return diag.FromErr(fmt.Errorf("error cancelling Copilot seats of users in %s: %w", orgName, err))
+		}
+	}
+
+	return nil
+}
+
+// diffCopilotSeatAssignments returns the configured assignees which weren't assigned a seat yet, and the assignees
+// which were assigned a seat but aren't configured. Assignees are keyed by lowercased name.
+func diffCopilotSeatAssignments(assigned map[string]string, configured []string) (add, remove []string) {
+	wanted := make(map[string]bool, len(configured))
+	for _, name := range configured {
+		wanted[strings.ToLower(name)] = true
+		if _, ok := assigned[strings.ToLower(name)]; !ok {
+			add = append(add, name)
+		}
+	}
+	for key, name := range assigned {
+		if !wanted[key] {
+			remove = append(remove, name)
+		}
+	}
+	slices.Sort(add)
+	slices.Sort(remove)
+
+	return add, remove
+}
+
+// flattenCopilotSeatAssignments returns the assignees which were assigned a seat, in their configured case if they are
+// configured.
+func flattenCopilotSeatAssignments(assigned map[string]string, configured *schema.Set) []string {
+	result := make([]string, 0, len(assigned))
+	names := make(map[string]bool, configured.Len())
+	for _, name := range expandStringList(configured.List()) {
+		key := strings.ToLower(name)
+		if _, ok := assigned[key]; ok && !names[key] {
+			result = append(result, name)
+		}
+		names[key] = true
+	}
+
+	for key, name := range assigned {
+		if !names[key] {
+			result = append(result, name)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testCopilotSeats = `{
+	"total_seats": 4,
+	"seats": [
+		{"assignee": {"login": "Octocat", "type": "User"}, "created_at": "2026-01-02T03:04:05Z"},
+		{"assignee": {"login": "hubot", "type": "User"}, "assigning_team": {"slug": "bots"}, "created_at": "2026-01-02T03:04:05Z"},
+		{"assignee": {"login": "monalisa", "type": "User"}, "created_at": "2026-01-02T03:04:05Z"},
+		{"assignee": {"login": "leaver", "type": "User"}, "pending_cancellation_date": "2026-02-01", "created_at": "2026-01-02T03:04:05Z"}
+	]
+}`
+
+func TestGithubCopilotOrganizationSeatAssignmentsCreate(t *testing.T) {
+	requests := make(map[string][]string)
+	record := func(key, field string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			var body map[string][]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Error(err)
+			}
+			requests[r.Method+" "+key] = body[field]
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, `{}`)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/copilot/billing/seats", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, testCopilotSeats)
+	})
+	mux.HandleFunc("POST /orgs/test-org/copilot/billing/selected_teams", record("teams", "selected_teams"))
+	mux.HandleFunc("DELETE /orgs/test-org/copilot/billing/selected_teams", record("teams", "selected_teams"))
+	mux.HandleFunc("POST /orgs/test-org/copilot/billing/selected_users", record("users", "selected_usernames"))
+	mux.HandleFunc("DELETE /orgs/test-org/copilot/billing/selected_users", record("users", "selected_usernames"))
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCopilotOrganizationSeatAssignments().Schema, map[string]any{
+		"teams": []any{"designers"},
+		"users": []any{"octocat", "hubot", "leaver"},
+	})
+
+	if diags := resourceGithubCopilotOrganizationSeatAssignmentsCreateOrUpdate(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for key, want := range map[string][]string{
+		"POST teams":   {"designers"},
+		"DELETE teams": {"bots"},
+		// hubot only has a seat through the bots team, whose seats are cancelled.
+		"POST users":   {"hubot", "leaver"},
+		"DELETE users": {"monalisa"},
+	} {
+		if got := requests[key]; !slices.Equal(got, want) {
+			t.Errorf("got %s %v; want %v", key, got, want)
+		}
+	}
+
+	if d.Id() != "test-org" {
+		t.Errorf("got ID %q; want %q", d.Id(), "test-org")
+	}
+	// The mocked seats aren't updated, so only the users with a direct seat are read back, in their configured case.
+	users := expandStringList(d.Get("users").(*schema.Set).List())
+	slices.Sort(users)
+	if want := []string{"monalisa", "octocat"}; !slices.Equal(users, want) {
+		t.Errorf("got users %v; want %v", users, want)
+	}
+}
+
+func TestGithubCopilotOrganizationSeatAssignmentsRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/copilot/billing/seats", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, testCopilotSeats)
+	})
+	mux.HandleFunc("GET /orgs/test-org/teams/empty/members", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[]`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/teams/designers/members", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[{"login": "monalisa"}]`)
+	})
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubCopilotOrganizationSeatAssignments().Schema, map[string]any{
+		"teams": []any{"bots", "empty", "designers", "deleted"},
+		"users": []any{"octocat", "hubot"},
+	})
+	d.SetId("test-org")
+
+	if diags := resourceGithubCopilotOrganizationSeatAssignmentsRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	// A team without members has no seats to read its assignment from, so it is kept as long as it exists.
+	teams := expandStringList(d.Get("teams").(*schema.Set).List())
+	slices.Sort(teams)
+	if want := []string{"bots", "empty"}; !slices.Equal(teams, want) {
+		t.Errorf("got teams %v; want %v", teams, want)
+	}
+	// hubot only has a seat through a team, so the direct seat has to be restored.
+	users := expandStringList(d.Get("users").(*schema.Set).List())
+	slices.Sort(users)
+	if want := []string{"monalisa", "octocat"}; !slices.Equal(users, want) {
+		t.Errorf("got users %v; want %v", users, want)
+	}
+}
+
+func TestAccGithubCopilotOrganizationSeatAssignments(t *testing.T) {
+	t.Run("manages the Copilot seat assignments of an organization", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_copilot_organization_seat_assignments" "test" {
+				users = ["%s"]
+			}
+		`, testAccConf.testOrgUser)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasPaidOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_copilot_organization_seat_assignments.test", "users.#", "1"),
+						resource.TestCheckResourceAttr("github_copilot_organization_seat_assignments.test", "teams.#", "0"),
+					),
+				},
+				{
+					ResourceName:      "github_copilot_organization_seat_assignments.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,461 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubEnterpriseRuleset() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Creates and manages a ruleset for the repositories of the organizations of an enterprise.",
+		CreateContext: resourceGithubEnterpriseRulesetCreate,
+		ReadContext:   resourceGithubEnterpriseRulesetRead,
+		UpdateContext: resourceGithubEnterpriseRulesetUpdate,
+		DeleteContext: resourceGithubEnterpriseRulesetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubEnterpriseRulesetImport,
+		},
+
+		CustomizeDiff: resourceGithubEnterpriseRulesetDiff,
+
+		Schema: map[string]*schema.Schema{
+			"enterprise_slug": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The slug of the enterprise (e.g. from the enterprise URL).",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 255)),
+			},
+			"name": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringLenBetween(1, 100)),
+				Description:      "The name of the ruleset.",
+			},
+			"target": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(supportedOrgRulesetTargetTypes, false)),
+				Description:      "The target of the ruleset. Possible values are " + strings.Join(supportedOrgRulesetTargetTypes[:len(supportedOrgRulesetTargetTypes)-1], ", ") + " and " + supportedOrgRulesetTargetTypes[len(supportedOrgRulesetTargetTypes)-1] + ".",
+			},
+			"enforcement": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"disabled", "active", "evaluate"}, false)),
+				Description:      "The enforcement level of the ruleset. `evaluate` allows admins to test rules before enforcing them. Possible values are `disabled`, `active`, and `evaluate`.",
+			},
+			"bypass_actors": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: bypassActorsDiffSuppressFunc,
+				Description:      "The actors that can bypass the rules in this ruleset.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"actor_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     nil,
+							Description: "The ID of the actor that can bypass a ruleset. When `actor_type` is `EnterpriseOwner` or `OrganizationAdmin`, this should be set to `1`. When `actor_type` is `EnterpriseTeam`, this is the `team_id` of a `github_enterprise_team`. Some resources such as DeployKey do not have an ID and this should be omitted.",
+						},
+						"actor_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"EnterpriseOwner", "EnterpriseTeam", "Integration", "OrganizationAdmin", "RepositoryRole", "Team", "DeployKey"}, false)),
+							Description:      "The type of actor that can bypass a ruleset. Can be one of: `EnterpriseOwner`, `EnterpriseTeam`, `Integration`, `OrganizationAdmin`, `RepositoryRole`, `Team`, or `DeployKey`.",
+						},
+						"bypass_mode": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"always", "pull_request", "exempt"}, false)),
+							Description:      "When the specified actor can bypass the ruleset. pull_request means that an actor can only bypass rules on pull requests. Can be one of: `always`, `pull_request`, `exempt`.",
+						},
+					},
+				},
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "GraphQL global node id for use with v4 API.",
+			},
+			"ruleset_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "GitHub ID for the ruleset.",
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Parameters for an enterprise ruleset condition. `ref_name` is required for `branch` and `tag` targets, but must not be set for `push` targets. Exactly one of `organization_name`, `organization_id` or `organization_property`, and exactly one of `repository_name` or `repository_property` are always required.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ref_name": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Targets refs that match the specified patterns. Required for `branch` and `tag` targets.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "Array of ref names or patterns to include. One of these patterns must match for the condition to pass. Also accepts `~DEFAULT_BRANCH` to include the default branch or `~ALL` to include all branches.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "Array of ref names or patterns to exclude. The condition will not pass if any of these patterns match.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"organization_name": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Description:  "Targets organizations that match the specified name patterns.",
+							ExactlyOneOf: []string{"conditions.0.organization_id", "conditions.0.organization_property"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "Array of organization names or patterns to include. One of these patterns must match for the condition to pass. Also accepts `~ALL` to include all organizations.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "Array of organization names or patterns to exclude. The condition will not pass if any of these patterns match.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+								},
+							},
+						},
+						"organization_id": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The organization IDs that the ruleset applies to. One of these IDs must match for the condition to pass.",
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+						"organization_property": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Targets organizations that match the specified property values.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The organization properties and values to include. All of these properties must match for the condition to pass.",
+										Elem:        repositoryPropertyTargetResource(),
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The organization properties and values to exclude. The condition will not pass if any of these properties match.",
+										Elem:        repositoryPropertyTargetResource(),
+									},
+								},
+							},
+						},
+						"repository_name": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							Description:  "Targets repositories that match the specified name patterns.",
+							ExactlyOneOf: []string{"conditions.0.repository_property"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "Array of repository names or patterns to include. One of these patterns must match for the condition to pass. Also accepts `~ALL` to include all repositories.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "Array of repository names or patterns to exclude. The condition will not pass if any of these patterns match.",
+										Elem: &schema.Schema{
+											Type: schema.TypeString,
+										},
+									},
+									"protected": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Default:     false,
+										Description: "Whether renaming of target repositories is prevented.",
+									},
+								},
+							},
+						},
+						"repository_property": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Targets repositories that match the specified custom property values.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"include": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The repository properties and values to include. All of these properties must match for the condition to pass.",
+										Elem:        repositoryPropertyTargetResource(),
+									},
+									"exclude": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The repository properties and values to exclude. The condition will not pass if any of these properties match.",
+										Elem:        repositoryPropertyTargetResource(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			// Enterprise rulesets support the same rules as organization rulesets.
+			"rules": resourceGithubOrganizationRuleset().Schema["rules"],
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "An etag representing the ruleset for caching purposes.",
+			},
+		},
+	}
+}
+
+func resourceGithubEnterpriseRulesetObject(d *schema.ResourceData) github.RepositoryRuleset {
+	target := github.RulesetTarget(d.Get("target").(string))
+	sourceType := github.RulesetSourceTypeEnterprise
+
+	return github.RepositoryRuleset{
+		Name:         d.Get("name").(string),
+		Target:       &target,
+		Source:       d.Get("enterprise_slug").(string),
+		SourceType:   &sourceType,
+		Enforcement:  github.RulesetEnforcement(d.Get("enforcement").(string)),
+		BypassActors: expandBypassActors(d.Get("bypass_actors").([]any)),
+		Conditions:   expandEnterpriseConditions(d.Get("conditions").([]any)),
+		Rules:        expandRules(d.Get("rules").([]any), true),
+	}
+}
+
+func resourceGithubEnterpriseRulesetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating enterprise ruleset: %s/%s", enterpriseSlug, name), map[string]any{
+		"enterprise": enterpriseSlug,
+		"name":       name,
+	})
+
+	ruleset, resp, err := client.Enterprise.CreateRepositoryRuleset(ctx, enterpriseSlug, resourceGithubEnterpriseRulesetObject(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating enterprise ruleset %s/%s: %w", enterpriseSlug, name, err))
+	}
+
+	d.SetId(strconv.FormatInt(ruleset.GetID(), 10))
+	if err := d.Set("ruleset_id", ruleset.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("node_id", ruleset.GetNodeID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("etag", resp.Header.Get("ETag")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rules", flattenRules(ctx, ruleset.Rules, true)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Created enterprise ruleset: %s/%s (ID: %d)", enterpriseSlug, name, ruleset.GetID()), map[string]any{
+		"enterprise": enterpriseSlug,
+		"name":       name,
+		"ruleset_id": ruleset.GetID(),
+	})
+
+	return nil
+}
+
+func resourceGithubEnterpriseRulesetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(unconvertibleIdErr(d.Id(), err))
+	}
+
+	if !d.IsNewResource() {
+		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
+	}
+
+	ruleset, resp, err := client.Enterprise.GetRepositoryRuleset(ctx, enterpriseSlug, rulesetID)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				tflog.Info(ctx, fmt.Sprintf("Removing enterprise ruleset %s/%d from state because it no longer exists in GitHub", enterpriseSlug, rulesetID), map[string]any{
+					"enterprise": enterpriseSlug,
+					"ruleset_id": rulesetID,
+				})
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(fmt.Errorf("error reading enterprise ruleset %s/%d: %w", enterpriseSlug, rulesetID, err))
+	}
+
+	if err := d.Set("ruleset_id", ruleset.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", ruleset.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("target", ruleset.GetTarget()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("enforcement", ruleset.Enforcement); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("bypass_actors", flattenBypassActors(ruleset.BypassActors)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("conditions", flattenEnterpriseConditions(ctx, ruleset.GetConditions())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rules", flattenRules(ctx, ruleset.Rules, true)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("node_id", ruleset.GetNodeID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("etag", resp.Header.Get("ETag")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseRulesetUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(unconvertibleIdErr(d.Id(), err))
+	}
+
+	ruleset, resp, err := client.Enterprise.UpdateRepositoryRuleset(ctx, enterpriseSlug, rulesetID, resourceGithubEnterpriseRulesetObject(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating enterprise ruleset %s/%d: %w", enterpriseSlug, rulesetID, err))
+	}
+
+	if err := d.Set("ruleset_id", ruleset.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("node_id", ruleset.GetNodeID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("etag", resp.Header.Get("ETag")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updated enterprise ruleset: %s/%d", enterpriseSlug, rulesetID), map[string]any{
+		"enterprise": enterpriseSlug,
+		"ruleset_id": rulesetID,
+	})
+
+	return nil
+}
+
+func resourceGithubEnterpriseRulesetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	enterpriseSlug := d.Get("enterprise_slug").(string)
+
+	rulesetID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(unconvertibleIdErr(d.Id(), err))
+	}
+
+	_, err = client.Enterprise.DeleteRepositoryRuleset(ctx, enterpriseSlug, rulesetID)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error deleting enterprise ruleset %s/%d: %w", enterpriseSlug, rulesetID, err))
+	}
+
+	return nil
+}
+
+func resourceGithubEnterpriseRulesetImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	// Import format: <enterprise_slug>/<ruleset_id>
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid import specified: supplied import must be written as <enterprise_slug>/<ruleset_id>")
+	}
+
+	enterpriseSlug, rulesetID := parts[0], parts[1]
+	if _, err := strconv.ParseInt(rulesetID, 10, 64); err != nil {
+		return nil, unconvertibleIdErr(rulesetID, err)
+	}
+
+	d.SetId(rulesetID)
+	if err := d.Set("enterprise_slug", enterpriseSlug); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceGithubEnterpriseRulesetDiff(ctx context.Context, d *schema.ResourceDiff, _ any) error {
+	err := validateRulesetConditions(ctx, d, true)
+	if err != nil {
+		return err
+	}
+
+	err = validateEnterpriseRulesetConditions(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	err = validateRulesetRules(ctx, d)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubEnterpriseRulesetConditions(t *testing.T) {
+	input := []any{
+		map[string]any{
+			"ref_name": []any{
+				map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}},
+			},
+			"organization_property": []any{
+				map[string]any{
+					"include": []any{
+						map[string]any{"name": "business_unit", "property_values": []any{"payments"}, "source": "custom"},
+					},
+					"exclude": []any{},
+				},
+			},
+			"repository_name": []any{
+				map[string]any{"include": []any{"~ALL"}, "exclude": []any{"sandbox-*"}, "protected": true},
+			},
+		},
+	}
+
+	conditions := expandEnterpriseConditions(input)
+	if conditions.OrganizationName != nil || conditions.OrganizationID != nil {
+		t.Fatalf("expected only organization_property to be set, got %+v", conditions)
+	}
+	if conditions.OrganizationProperty == nil || len(conditions.OrganizationProperty.Include) != 1 {
+		t.Fatalf("expected 1 included organization property, got %+v", conditions.OrganizationProperty)
+	}
+	if conditions.RepositoryName == nil || !conditions.RepositoryName.GetProtected() {
+		t.Fatalf("expected a protected repository_name condition, got %+v", conditions.RepositoryName)
+	}
+
+	result := flattenEnterpriseConditions(t.Context(), conditions)
+	if len(result) != 1 {
+		t.Fatalf("expected 1 conditions block, got %d", len(result))
+	}
+	conditionsMap := result[0].(map[string]any)
+
+	organizationProperty := conditionsMap["organization_property"].([]map[string]any)
+	include := organizationProperty[0]["include"].([]map[string]any)
+	if len(include) != 1 || include[0]["name"] != "business_unit" || include[0]["source"] != "custom" {
+		t.Errorf("unexpected organization_property include: %v", include)
+	}
+	if _, ok := conditionsMap["organization_name"]; ok {
+		t.Errorf("expected organization_name to be unset, got %v", conditionsMap["organization_name"])
+	}
+	repositoryName := conditionsMap["repository_name"].([]map[string]any)
+	if len(repositoryName) != 1 || repositoryName[0]["protected"] != true {
+		t.Errorf("unexpected repository_name: %v", repositoryName)
+	}
+
+	ids := flattenEnterpriseConditions(t.Context(), &github.RepositoryRulesetConditions{
+		OrganizationID: &github.RepositoryRulesetOrganizationIDsConditionParameters{OrganizationIDs: []int64{1, 2}},
+		RepositoryName: &github.RepositoryRulesetRepositoryNamesConditionParameters{Include: []string{"~ALL"}, Exclude: []string{}},
+	})
+	if got := ids[0].(map[string]any)["organization_id"].([]int64); len(got) != 2 {
+		t.Errorf("expected 2 organization IDs, got %v", got)
+	}
+}
+
+func TestGithubEnterpriseRulesetDiff(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		conditions map[string]any
+		wantErr    string
+	}{
+		{
+			name: "organization and repository conditions",
+			conditions: map[string]any{
+				"organization_id": []any{1},
+				"repository_name": []any{map[string]any{"include": []any{"~ALL"}, "exclude": []any{}}},
+			},
+		},
+		{
+			name: "missing organization condition",
+			conditions: map[string]any{
+				"repository_name": []any{map[string]any{"include": []any{"~ALL"}, "exclude": []any{}}},
+			},
+			wantErr: "one of organization_name, organization_id or organization_property must be set for push target",
+		},
+		{
+			name: "ref_name on push target",
+			conditions: map[string]any{
+				"ref_name":        []any{map[string]any{"include": []any{"~ALL"}, "exclude": []any{}}},
+				"organization_id": []any{1},
+				"repository_name": []any{map[string]any{"include": []any{"~ALL"}, "exclude": []any{}}},
+			},
+			wantErr: "ref_name must not be set for push target",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				"enterprise_slug": "test-enterprise",
+				"name":            "test",
+				"target":          "push",
+				"enforcement":     "active",
+				"conditions":      []any{tt.conditions},
+				"rules":           []any{map[string]any{"max_file_size": []any{map[string]any{"max_file_size": 100}}}},
+			})
+
+			_, err := resourceGithubEnterpriseRuleset().Diff(t.Context(), nil, config, &Owner{})
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v; want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccGithubEnterpriseRuleset(t *testing.T) {
+	t.Run("creates an enterprise ruleset with an enterprise team bypass actor", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		resourceName := "github_enterprise_ruleset.test"
+		config := func(enforcement string) string {
+			return fmt.Sprintf(`
+			resource "github_enterprise_team" "test" {
+				enterprise_slug = "%[1]s"
+				name            = "%[2]steam-%[3]s"
+			}
+
+			resource "github_enterprise_ruleset" "test" {
+				enterprise_slug = "%[1]s"
+				name            = "%[2]sruleset-%[3]s"
+				target          = "branch"
+				enforcement     = "%[4]s"
+
+				bypass_actors {
+					actor_id    = 1
+					actor_type  = "EnterpriseOwner"
+					bypass_mode = "always"
+				}
+
+				bypass_actors {
+					actor_id    = github_enterprise_team.test.team_id
+					actor_type  = "EnterpriseTeam"
+					bypass_mode = "pull_request"
+				}
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+
+					organization_name {
+						include = ["~ALL"]
+						exclude = []
+					}
+
+					repository_name {
+						include = ["~ALL"]
+						exclude = []
+					}
+				}
+
+				rules {
+					deletion         = true
+					non_fast_forward = true
+				}
+			}
+		`, testAccConf.enterpriseSlug, testResourcePrefix, randomID, enforcement)
+		}
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessMode(t, enterprise) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config("evaluate"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "enforcement", "evaluate"),
+						resource.TestCheckResourceAttr(resourceName, "bypass_actors.#", "2"),
+						resource.TestCheckResourceAttr(resourceName, "conditions.0.organization_name.0.include.0", "~ALL"),
+						resource.TestCheckResourceAttr(resourceName, "rules.0.deletion", "true"),
+						resource.TestCheckResourceAttrSet(resourceName, "ruleset_id"),
+					),
+				},
+				{
+					Config: config("active"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceName, "enforcement", "active"),
+					),
+				},
+				{
+					ResourceName:            resourceName,
+					ImportState:             true,
+					ImportStateIdPrefix:     testAccConf.enterpriseSlug + "/",
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"etag"},
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,576 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// codeSecurityConfigurationFeature describes a feature toggle of a code security configuration.
+type codeSecurityConfigurationFeature struct {
+	name        string
+	description string
+	values      []string
+	field       func(*github.CodeSecurityConfiguration) **string
+}
+
+var codeSecurityFeatureValues = []string{"enabled", "disabled", "not_set"}
+
+var codeSecurityConfigurationFeatures = []codeSecurityConfigurationFeature{
+	{
+		name:        "advanced_security",
+		description: "The enablement status of GitHub Advanced Security. `code_security` and `secret_protection` enable the respective standalone products.",
+		values:      []string{"enabled", "disabled", "code_security", "secret_protection"},
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.AdvancedSecurity },
+	},
+	{
+		name:        "code_security",
+		description: "The enablement status of GitHub Code Security.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.CodeSecurity },
+	},
+	{
+		name:        "secret_protection",
+		description: "The enablement status of GitHub Secret Protection.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretProtection },
+	},
+	{
+		name:        "dependency_graph",
+		description: "The enablement status of the dependency graph.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.DependencyGraph },
+	},
+	{
+		name:        "dependency_graph_autosubmit_action",
+		description: "The enablement status of automatic dependency submission.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.DependencyGraphAutosubmitAction },
+	},
+	{
+		name:        "dependabot_alerts",
+		description: "The enablement status of Dependabot alerts.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.DependabotAlerts },
+	},
+	{
+		name:        "dependabot_security_updates",
+		description: "The enablement status of Dependabot security updates.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.DependabotSecurityUpdates },
+	},
+	{
+		name:        "code_scanning_default_setup",
+		description: "The enablement status of code scanning default setup.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.CodeScanningDefaultSetup },
+	},
+	{
+		name:        "code_scanning_delegated_alert_dismissal",
+		description: "The enablement status of code scanning delegated alert dismissal.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.CodeScanningDelegatedAlertDismissal },
+	},
+	{
+		name:        "secret_scanning",
+		description: "The enablement status of secret scanning.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretScanning },
+	},
+	{
+		name:        "secret_scanning_push_protection",
+		description: "The enablement status of secret scanning push protection.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretScanningPushProtection },
+	},
+	{
+		name:        "secret_scanning_delegated_bypass",
+		description: "The enablement status of secret scanning delegated bypass.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretScanningDelegatedBypass },
+	},
+	{
+		name:        "secret_scanning_validity_checks",
+		description: "The enablement status of secret scanning validity checks.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretScanningValidityChecks },
+	},
+	{
+		name:        "secret_scanning_non_provider_patterns",
+		description: "The enablement status of secret scanning non-provider patterns.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretScanningNonProviderPatterns },
+	},
+	{
+		name:        "secret_scanning_generic_secrets",
+		description: "The enablement status of Copilot secret scanning for generic secrets.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretScanningGenericSecrets },
+	},
+	{
+		name:        "secret_scanning_delegated_alert_dismissal",
+		description: "The enablement status of secret scanning delegated alert dismissal.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.SecretScanningDelegatedAlertDismissal },
+	},
+	{
+		name:        "private_vulnerability_reporting",
+		description: "The enablement status of private vulnerability reporting.",
+		values:      codeSecurityFeatureValues,
+		field:       func(c *github.CodeSecurityConfiguration) **string { return &c.PrivateVulnerabilityReporting },
+	},
+}
+
+func resourceGithubOrganizationCodeSecurityConfiguration() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"configuration_id": {
+			Description: "The ID of the code security configuration.",
+			Type:        schema.TypeInt,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the code security configuration. Must be unique within the organization.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"description": {
+			Description: "The description of the code security configuration.",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
+		"dependency_graph_autosubmit_action_options": {
+			Description: "The options for automatic dependency submission.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"labeled_runners": {
+						Description: "Whether to use runners labeled with `dependency-submission` rather than GitHub-hosted runners.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"code_scanning_default_setup_options": {
+			Description: "The options for code scanning default setup.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"runner_type": {
+						Description:      "Whether to run CodeQL on standard GitHub-hosted runners or on runners with `runner_label`. Can be one of `standard`, `labeled` or `not_set`.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "not_set",
+						ValidateDiagFunc: validateValueFunc([]string{"standard", "labeled", "not_set"}),
+					},
+					"runner_label": {
+						Description: "The label of the runners to run CodeQL on when `runner_type` is `labeled`.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
+		"code_scanning_options": {
+			Description: "The options for code scanning.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"allow_advanced": {
+						Description: "Whether to allow repositories with default setup to switch to advanced setup.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
+		"secret_scanning_delegated_bypass_options": {
+			Description: "The options for secret scanning delegated bypass.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"reviewer": {
+						Description: "A team or role allowed to review push protection bypass requests.",
+						Type:        schema.TypeSet,
+						Required:    true,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"reviewer_id": {
+									Description: "The ID of the team or role.",
+									Type:        schema.TypeInt,
+									Required:    true,
+								},
+								"reviewer_type": {
+									Description:      "The type of the reviewer. Can be one of `TEAM` or `ROLE`.",
+									Type:             schema.TypeString,
+									Required:         true,
+									ValidateDiagFunc: validateValueFunc([]string{"TEAM", "ROLE"}),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"enforcement": {
+			Description:      "Whether the configuration is enforced, which prevents repositories from changing the settings it manages. Can be one of `enforced` or `unenforced`.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateValueFunc([]string{"enforced", "unenforced"}),
+		},
+		"default_for_new_repos": {
+			Description:      "The new repositories the configuration is applied to by default. Can be one of `all`, `none`, `private_and_internal` or `public`.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "none",
+			ValidateDiagFunc: validateValueFunc([]string{"all", "none", "private_and_internal", "public"}),
+		},
+		"target_type": {
+			Description: "The type of the code security configuration.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"html_url": {
+			Description: "The URL of the code security configuration.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+	for _, feature := range codeSecurityConfigurationFeatures {
+		s[feature.name] = &schema.Schema{
+			Description:      feature.description,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateDiagFunc: validateValueFunc(feature.values),
+		}
+	}
+
+	return &schema.Resource{
+		Description: "Manage a code security configuration of an organization.",
+
+		CreateContext: resourceGithubOrganizationCodeSecurityConfigurationCreate,
+		ReadContext:   resourceGithubOrganizationCodeSecurityConfigurationRead,
+		UpdateContext: resourceGithubOrganizationCodeSecurityConfigurationUpdate,
+		DeleteContext: resourceGithubOrganizationCodeSecurityConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	config := expandCodeSecurityConfiguration(d, false)
+	created, _, err := client.Organizations.CreateCodeSecurityConfiguration(ctx, orgName, *config)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating GitHub organization code security configuration (%s/%s): %w", orgName, config.Name, err))
+	}
+
+	d.SetId(strconv.FormatInt(created.GetID(), 10))
+
+	if defaultForNewRepos := d.Get("default_for_new_repos").(string); defaultForNewRepos != "none" {
+		_, _, err = client.Organizations.SetDefaultCodeSecurityConfiguration(ctx, orgName, created.GetID(), defaultForNewRepos)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGithubOrganizationCodeSecurityConfigurationRead(ctx, d, meta)
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config, _, err := client.Organizations.GetCodeSecurityConfiguration(ctx, orgName, configurationID)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				tflog.Warn(ctx, "GitHub organization code security configuration not found, removing from state", map[string]any{
+					"orgName":         orgName,
+					"configurationId": configurationID,
+				})
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	defaultForNewRepos, err := getCodeSecurityConfigurationDefaultForNewRepos(ctx, client, orgName, configurationID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("configuration_id", config.GetID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("name", config.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("description", config.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range flattenCodeSecurityConfigurationFeatures(config) {
+		if err = d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("dependency_graph_autosubmit_action_options", flattenDependencyGraphAutosubmitActionOptions(config.DependencyGraphAutosubmitActionOptions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("code_scanning_default_setup_options", flattenCodeScanningDefaultSetupOptions(config.CodeScanningDefaultSetupOptions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("code_scanning_options", flattenCodeScanningOptions(config.CodeScanningOptions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("secret_scanning_delegated_bypass_options", flattenSecretScanningDelegatedBypassOptions(config.SecretScanningDelegatedBypassOptions)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("enforcement", config.GetEnforcement()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("default_for_new_repos", defaultForNewRepos); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("target_type", config.GetTargetType()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("html_url", config.GetHTMLURL()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	config := expandCodeSecurityConfiguration(d, true)
+	_, _, err = client.Organizations.UpdateCodeSecurityConfiguration(ctx, orgName, configurationID, *config)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating GitHub organization code security configuration (%s/%s): %w", orgName, config.Name, err))
+	}
+
+	if d.HasChange("default_for_new_repos") {
+		_, _, err = client.Organizations.SetDefaultCodeSecurityConfiguration(ctx, orgName, configurationID, d.Get("default_for_new_repos").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGithubOrganizationCodeSecurityConfigurationRead(ctx, d, meta)
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = client.Organizations.DeleteCodeSecurityConfiguration(ctx, orgName, configurationID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting GitHub organization code security configuration %d: %w", configurationID, err))
+	}
+
+	return nil
+}
+
+// expandCodeSecurityConfiguration builds the configuration to create or, when update is set, the changes to apply.
+// Features left unset are omitted, so GitHub applies its defaults to them.
+func expandCodeSecurityConfiguration(d *schema.ResourceData, update bool) *github.CodeSecurityConfiguration {
+	config := &github.CodeSecurityConfiguration{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+	}
+
+	changed := func(key string) bool {
+		if update {
+			return d.HasChange(key)
+		}
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	for _, feature := range codeSecurityConfigurationFeatures {
+		if changed(feature.name) {
+			*feature.field(config) = new(d.Get(feature.name).(string))
+		}
+	}
+	if changed("enforcement") {
+		config.Enforcement = new(d.Get("enforcement").(string))
+	}
+	if changed("dependency_graph_autosubmit_action_options") {
+		config.DependencyGraphAutosubmitActionOptions = &github.DependencyGraphAutosubmitActionOptions{
+			LabeledRunners: new(d.Get("dependency_graph_autosubmit_action_options.0.labeled_runners").(bool)),
+		}
+	}
+	if changed("code_scanning_default_setup_options") {
+		options := &github.CodeScanningDefaultSetupOptions{
+			RunnerType: d.Get("code_scanning_default_setup_options.0.runner_type").(string),
+		}
+		if label := d.Get("code_scanning_default_setup_options.0.runner_label").(string); label != "" {
+			options.RunnerLabel = new(label)
+		}
+		config.CodeScanningDefaultSetupOptions = options
+	}
+	if changed("code_scanning_options") {
+		config.CodeScanningOptions = &github.CodeScanningOptions{
+			AllowAdvanced: new(d.Get("code_scanning_options.0.allow_advanced").(bool)),
+		}
+	}
+	if changed("secret_scanning_delegated_bypass_options") {
+		options := &github.SecretScanningDelegatedBypassOptions{Reviewers: make([]*github.BypassReviewer, 0)}
+		if v, ok := d.GetOk("secret_scanning_delegated_bypass_options.0.reviewer"); ok {
+			for _, r := range v.(*schema.Set).List() {
+				reviewer := r.(map[string]any)
+				options.Reviewers = append(options.Reviewers, &github.BypassReviewer{
+					ReviewerID:   int64(reviewer["reviewer_id"].(int)),
+					ReviewerType: reviewer["reviewer_type"].(string),
+				})
+			}
+		}
+		config.SecretScanningDelegatedBypassOptions = options
+	}
+
+	return config
+}
+
+// getCodeSecurityConfigurationDefaultForNewRepos returns the new repositories a configuration is applied to by default.
+func getCodeSecurityConfigurationDefaultForNewRepos(ctx context.Context, client *github.Client, orgName string, configurationID int64) (string, error) {
+	defaults, _, err := client.Organizations.ListDefaultCodeSecurityConfigurations(ctx, orgName)
+	if err != nil {
+		return "", err
+	}
+
+	for _, def := range defaults {
+		if def.GetConfiguration().GetID() == configurationID {
+			return def.GetDefaultForNewRepos(), nil
+		}
+	}
+
+	return "none", nil
+}
+
+func flattenCodeSecurityConfigurationFeatures(config *github.CodeSecurityConfiguration) map[string]any {
+	features := make(map[string]any, len(codeSecurityConfigurationFeatures))
+	for _, feature := range codeSecurityConfigurationFeatures {
+		var value string
+		if v := *feature.field(config); v != nil {
+			value = *v
+		}
+		features[feature.name] = value
+	}
+	return features
+}
+
+func flattenDependencyGraphAutosubmitActionOptions(options *github.DependencyGraphAutosubmitActionOptions) []any {
+	if options == nil {
+		return []any{}
+	}
+
+	return []any{map[string]any{
+		"labeled_runners": options.GetLabeledRunners(),
+	}}
+}
+
+func flattenCodeScanningDefaultSetupOptions(options *github.CodeScanningDefaultSetupOptions) []any {
+	if options == nil {
+		return []any{}
+	}
+
+	return []any{map[string]any{
+		"runner_type":  options.RunnerType,
+		"runner_label": options.GetRunnerLabel(),
+	}}
+}
+
+func flattenCodeScanningOptions(options *github.CodeScanningOptions) []any {
+	if options == nil {
+		return []any{}
+	}
+
+	return []any{map[string]any{
+		"allow_advanced": options.GetAllowAdvanced(),
+	}}
+}
+
+func flattenSecretScanningDelegatedBypassOptions(options *github.SecretScanningDelegatedBypassOptions) []any {
+	if options == nil || len(options.Reviewers) == 0 {
+		return []any{}
+	}
+
+	reviewers := make([]any, 0, len(options.Reviewers))
+	for _, reviewer := range options.Reviewers {
+		reviewers = append(reviewers, map[string]any{
+			"reviewer_id":   int(reviewer.ReviewerID),
+			"reviewer_type": reviewer.ReviewerType,
+		})
+	}
+
+	return []any{map[string]any{
+		"reviewer": reviewers,
+	}}
+}
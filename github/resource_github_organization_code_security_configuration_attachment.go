@@ -0,0 +1,295 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// maxCodeSecurityDetachRepositories is the number of repositories which can be detached from configurations at once.
+const maxCodeSecurityDetachRepositories = 250
+
+// codeSecurityAttachmentScopes are the scopes a configuration can be attached to repositories with.
+var codeSecurityAttachmentScopes = []string{"all", "all_without_configurations", "public", "private_or_internal", "selected"}
+
+// codeSecurityAttachedStatuses are the statuses of repositories which are, or are becoming, attached to a configuration.
+const codeSecurityAttachedStatuses = "attached,attaching,enforced,updating"
+
+func resourceGithubOrganizationCodeSecurityConfigurationAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attach a code security configuration to repositories of an organization.",
+
+		CreateContext: resourceGithubOrganizationCodeSecurityConfigurationAttachmentCreate,
+		ReadContext:   resourceGithubOrganizationCodeSecurityConfigurationAttachmentRead,
+		UpdateContext: resourceGithubOrganizationCodeSecurityConfigurationAttachmentUpdate,
+		DeleteContext: resourceGithubOrganizationCodeSecurityConfigurationAttachmentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubOrganizationCodeSecurityConfigurationAttachmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"configuration_id": {
+				Description: "The ID of the code security configuration.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scope": {
+				Description:      "The repositories to attach the configuration to. Can be one of `all`, `all_without_configurations`, `public`, `private_or_internal` or `selected`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validateValueFunc(codeSecurityAttachmentScopes),
+			},
+			"repository_ids": {
+				Description: "The IDs of the repositories to attach the configuration to when `scope` is `selected`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+		},
+
+		CustomizeDiff: resourceGithubOrganizationCodeSecurityConfigurationAttachmentDiff,
+	}
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationAttachmentDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	scope := d.Get("scope").(string)
+	repositoryIDs := d.Get("repository_ids").(*schema.Set).Len()
+
+	if scope == "selected" && repositoryIDs == 0 {
+		return fmt.Errorf("repository_ids must be set when scope is %q", scope)
+	}
+	if scope != "selected" && repositoryIDs > 0 {
+		return fmt.Errorf("repository_ids can only be set when scope is %q, got %q", "selected", scope)
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	configurationID := int64(d.Get("configuration_id").(int))
+	scope := d.Get("scope").(string)
+	repositoryIDs := expandCodeSecurityRepositoryIDs(d.Get("repository_ids").(*schema.Set))
+
+	// Attaching is processed asynchronously, repositories are reported as attaching until it completes.
+	_, err = client.Organizations.AttachCodeSecurityConfigurationToRepositories(ctx, orgName, configurationID, scope, repositoryIDs)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error attaching GitHub organization code security configuration %d: %w", configurationID, err))
+	}
+
+	d.SetId(strconv.FormatInt(configurationID, 10))
+
+	return resourceGithubOrganizationCodeSecurityConfigurationAttachmentRead(ctx, d, meta)
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationAttachmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	configurationID, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, _, err = client.Organizations.GetCodeSecurityConfiguration(ctx, orgName, configurationID)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				tflog.Warn(ctx, "GitHub organization code security configuration not found, removing attachment from state", map[string]any{
+					"orgName":         orgName,
+					"configurationId": configurationID,
+				})
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("configuration_id", int(configurationID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The repositories matching the other scopes change as repositories are created, so only the repositories
+	// of a selected scope are refreshed.
+	if d.Get("scope").(string) != "selected" {
+		return nil
+	}
+
+	attachments, err := listCodeSecurityConfigurationRepositories(ctx, client, orgName, configurationID, codeSecurityAttachedStatuses)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	repositoryIDs := make([]int, 0, len(attachments))
+	for _, attachment := range attachments {
+		repositoryIDs = append(repositoryIDs, int(attachment.GetRepository().GetID()))
+	}
+	if err = d.Set("repository_ids", repositoryIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	configurationID := int64(d.Get("configuration_id").(int))
+
+	if d.HasChange("repository_ids") {
+		o, n := d.GetChange("repository_ids")
+		removed := expandCodeSecurityRepositoryIDs(o.(*schema.Set).Difference(n.(*schema.Set)))
+		added := expandCodeSecurityRepositoryIDs(n.(*schema.Set).Difference(o.(*schema.Set)))
+
+		if err = detachCodeSecurityConfigurations(ctx, client, orgName, removed); err != nil {
+			return diag.FromErr(err)
+		}
+		if len(added) > 0 {
+			_, err = client.Organizations.AttachCodeSecurityConfigurationToRepositories(ctx, orgName, configurationID, "selected", added)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error attaching GitHub organization code security configuration %d: %w", configurationID, err))
+			}
+		}
+	}
+
+	return resourceGithubOrganizationCodeSecurityConfigurationAttachmentRead(ctx, d, meta)
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	configurationID := int64(d.Get("configuration_id").(int))
+
+	var repositoryIDs []int64
+	if d.Get("scope").(string) == "selected" {
+		repositoryIDs = expandCodeSecurityRepositoryIDs(d.Get("repository_ids").(*schema.Set))
+	} else {
+		// The repositories matched by the other scopes aren't known, so every repository attached to the configuration,
+		// including those attached outside of Terraform, is detached.
+		attachments, err := listCodeSecurityConfigurationRepositories(ctx, client, orgName, configurationID, codeSecurityAttachedStatuses)
+		if err != nil {
+			var ghErr *github.ErrorResponse
+			if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+				return nil
+			}
+			return diag.FromErr(err)
+		}
+		for _, attachment := range attachments {
+			repositoryIDs = append(repositoryIDs, attachment.GetRepository().GetID())
+		}
+	}
+
+	if err = detachCodeSecurityConfigurations(ctx, client, orgName, repositoryIDs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationCodeSecurityConfigurationAttachmentImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// <configuration_id>[:<scope>]
+	id, scope := d.Id(), "selected"
+	if strings.Contains(id, idSeparator) {
+		var err error
+		if id, scope, err = parseID2(id); err != nil {
+			return nil, err
+		}
+		if !slices.Contains(codeSecurityAttachmentScopes, scope) {
+			return nil, fmt.Errorf("invalid scope %q, expected one of %s", scope, strings.Join(codeSecurityAttachmentScopes, ", "))
+		}
+	}
+
+	configurationID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id (%s), expected <configuration_id> or <configuration_id>:<scope>", d.Id())
+	}
+
+	// Only the repositories attached to the configuration can be read back, so the scope defaults to selected.
+	d.SetId(id)
+	if err := d.Set("configuration_id", configurationID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("scope", scope); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// listCodeSecurityConfigurationRepositories returns the repositories with one of the given comma-separated
+// attachment statuses for a configuration.
+func listCodeSecurityConfigurationRepositories(ctx context.Context, client *github.Client, orgName string, configurationID int64, status string) ([]*github.RepositoryAttachment, error) {
+	var all []*github.RepositoryAttachment
+	opts := &github.ListCodeSecurityConfigurationRepositoriesOptions{PerPage: maxPerPage, Status: status}
+	for {
+		attachments, resp, err := client.Organizations.ListCodeSecurityConfigurationRepositories(ctx, orgName, configurationID, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, attachments...)
+
+		if resp.After == "" {
+			break
+		}
+		opts.After = resp.After
+	}
+
+	return all, nil
+}
+
+// detachCodeSecurityConfigurations detaches repositories from the configuration they are attached to.
+func detachCodeSecurityConfigurations(ctx context.Context, client *github.Client, orgName string, repositoryIDs []int64) error {
+	for chunk := range slices.Chunk(repositoryIDs, maxCodeSecurityDetachRepositories) {
+		_, err := client.Organizations.DetachCodeSecurityConfigurationsFromRepositories(ctx, orgName, chunk)
+		if err != nil {
+			return fmt.Errorf("error detaching GitHub organization code security configurations: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func expandCodeSecurityRepositoryIDs(set *schema.Set) []int64 {
+	repositoryIDs := make([]int64, 0, set.Len())
+	for _, v := range set.List() {
+		repositoryIDs = append(repositoryIDs, int64(v.(int)))
+	}
+	return repositoryIDs
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubOrganizationCodeSecurityConfigurationAttachmentRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations/17", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"id": 17, "name": "baseline"}`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations/17/repositories", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("status"); got != codeSecurityAttachedStatuses {
+			t.Errorf("got status %q; want %q", got, codeSecurityAttachedStatuses)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("after") == "" {
+			w.Header().Set("Link", fmt.Sprintf(`<%s?after=page2>; rel="next"`, r.URL.Path))
+			mustWrite(w, `[{"status": "attached", "repository": {"id": 1}}]`)
+			return
+		}
+		mustWrite(w, `[{"status": "attaching", "repository": {"id": 3}}]`)
+	})
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationCodeSecurityConfigurationAttachment().Schema, map[string]any{
+		"configuration_id": 17,
+		"scope":            "selected",
+		"repository_ids":   []any{1, 2},
+	})
+	d.SetId("17")
+
+	if diags := resourceGithubOrganizationCodeSecurityConfigurationAttachmentRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	repositoryIDs := d.Get("repository_ids").(*schema.Set)
+	if repositoryIDs.Len() != 2 || !repositoryIDs.Contains(1) || !repositoryIDs.Contains(3) {
+		t.Errorf("got repository_ids %v; want [1 3]", repositoryIDs.List())
+	}
+}
+
+func TestGithubOrganizationCodeSecurityConfigurationAttachmentImport(t *testing.T) {
+	for _, tc := range []struct {
+		id, scope string
+	}{
+		{id: "17", scope: "selected"},
+		{id: "17:selected", scope: "selected"},
+		{id: "17:all", scope: "all"},
+		{id: "17:private_or_internal", scope: "private_or_internal"},
+	} {
+		t.Run(tc.id, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceGithubOrganizationCodeSecurityConfigurationAttachment().Schema, map[string]any{})
+			d.SetId(tc.id)
+
+			if _, err := resourceGithubOrganizationCodeSecurityConfigurationAttachmentImport(t.Context(), d, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d.Id() != "17" {
+				t.Errorf("got ID %q; want %q", d.Id(), "17")
+			}
+			if got := d.Get("configuration_id").(int); got != 17 {
+				t.Errorf("got configuration_id %d; want 17", got)
+			}
+			if got := d.Get("scope").(string); got != tc.scope {
+				t.Errorf("got scope %q; want %q", got, tc.scope)
+			}
+		})
+	}
+
+	for _, id := range []string{"baseline", "17:everything", "17:all:extra"} {
+		t.Run(id, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceGithubOrganizationCodeSecurityConfigurationAttachment().Schema, map[string]any{})
+			d.SetId(id)
+
+			if _, err := resourceGithubOrganizationCodeSecurityConfigurationAttachmentImport(t.Context(), d, nil); err == nil {
+				t.Errorf("expected an error for ID %q", id)
+			}
+		})
+	}
+}
+
+func TestAccGithubOrganizationCodeSecurityConfigurationAttachment(t *testing.T) {
+	t.Run("attaches a configuration to selected repositories", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_repository" "test" {
+				count      = 2
+				name       = "%[1]scode-security-${count.index}-%[2]s"
+				visibility = "private"
+			}
+
+			resource "github_organization_code_security_configuration" "test" {
+				name             = "%[1]scode-security-%[2]s"
+				dependency_graph = "enabled"
+			}
+
+			resource "github_organization_code_security_configuration_attachment" "test" {
+				configuration_id = github_organization_code_security_configuration.test.configuration_id
+				scope            = "selected"
+				repository_ids   = %[3]s
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasPaidOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "[github_repository.test[0].repo_id]"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_organization_code_security_configuration_attachment.test", "repository_ids.#", "1"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "github_repository.test[*].repo_id"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_organization_code_security_configuration_attachment.test", "repository_ids.#", "2"),
+					),
+				},
+			},
+		})
+	})
+}
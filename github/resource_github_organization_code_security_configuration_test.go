@@ -0,0 +1,145 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubOrganizationCodeSecurityConfigurationCreate(t *testing.T) {
+	var created map[string]any
+	var defaultForNewRepos string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /orgs/test-org/code-security/configurations", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"id": 17, "name": "baseline"}`)
+	})
+	mux.HandleFunc("PUT /orgs/test-org/code-security/configurations/17/defaults", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			DefaultForNewRepos string `json:"default_for_new_repos"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatal(err)
+		}
+		defaultForNewRepos = body.DefaultForNewRepos
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, fmt.Sprintf(`{"default_for_new_repos": %q, "configuration": {"id": 17}}`, body.DefaultForNewRepos))
+	})
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations/17", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"id": 17,
+			"name": "baseline",
+			"description": "Baseline",
+			"target_type": "organization",
+			"dependency_graph": "enabled",
+			"code_scanning_default_setup": "enabled",
+			"code_scanning_default_setup_options": {"runner_type": "labeled", "runner_label": "codeql"},
+			"secret_scanning": "disabled",
+			"enforcement": "enforced"
+		}`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/code-security/configurations/defaults", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, fmt.Sprintf(`[{"default_for_new_repos": %q, "configuration": {"id": 17}}]`, defaultForNewRepos))
+	})
+
+	meta := newTestOwner(t, mux, "test-org", true)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationCodeSecurityConfiguration().Schema, map[string]any{
+		"name":                        "baseline",
+		"description":                 "Baseline",
+		"code_scanning_default_setup": "enabled",
+		"code_scanning_default_setup_options": []any{
+			map[string]any{"runner_type": "labeled", "runner_label": "codeql"},
+		},
+		"enforcement":           "enforced",
+		"default_for_new_repos": "private_and_internal",
+	})
+
+	if diags := resourceGithubOrganizationCodeSecurityConfigurationCreate(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if _, ok := created["secret_scanning"]; ok {
+		t.Errorf("expected unset features to be omitted, got secret_scanning %v", created["secret_scanning"])
+	}
+	if got := created["code_scanning_default_setup"]; got != "enabled" {
+		t.Errorf("got code_scanning_default_setup %v; want enabled", got)
+	}
+	if got := created["code_scanning_default_setup_options"]; fmt.Sprint(got) != "map[runner_label:codeql runner_type:labeled]" {
+		t.Errorf("got code_scanning_default_setup_options %v", got)
+	}
+	if defaultForNewRepos != "private_and_internal" {
+		t.Errorf("got default_for_new_repos %q; want %q", defaultForNewRepos, "private_and_internal")
+	}
+
+	if d.Id() != "17" {
+		t.Errorf("got ID %q; want %q", d.Id(), "17")
+	}
+	for k, want := range map[string]any{
+		"dependency_graph": "enabled",
+		"secret_scanning":  "disabled",
+		"code_scanning_default_setup_options.0.runner_label": "codeql",
+		"default_for_new_repos":                              "private_and_internal",
+		"target_type":                                        "organization",
+	} {
+		if got := d.Get(k); got != want {
+			t.Errorf("got %s %v; want %v", k, got, want)
+		}
+	}
+}
+
+func TestAccGithubOrganizationCodeSecurityConfiguration(t *testing.T) {
+	t.Run("creates and updates a code security configuration", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_organization_code_security_configuration" "test" {
+				name                        = "%[1]scode-security-%[2]s"
+				description                 = "Managed by Terraform"
+				dependency_graph            = "enabled"
+				dependabot_alerts           = "enabled"
+				code_scanning_default_setup = "%[3]s"
+				secret_scanning             = "enabled"
+				enforcement                 = "unenforced"
+				default_for_new_repos       = "%[4]s"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasPaidOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "disabled", "none"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrSet("github_organization_code_security_configuration.test", "configuration_id"),
+						resource.TestCheckResourceAttr("github_organization_code_security_configuration.test", "dependabot_alerts", "enabled"),
+						resource.TestCheckResourceAttr("github_organization_code_security_configuration.test", "default_for_new_repos", "none"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "enabled", "public"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_organization_code_security_configuration.test", "code_scanning_default_setup", "enabled"),
+						resource.TestCheckResourceAttr("github_organization_code_security_configuration.test", "default_for_new_repos", "public"),
+					),
+				},
+				{
+					ResourceName:      "github_organization_code_security_configuration.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
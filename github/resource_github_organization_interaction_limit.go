@@ -0,0 +1,94 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubOrganizationInteractionLimit() *schema.Resource {
+	return &schema.Resource{
+		Description: "Limit who can interact with the public repositories of an organization for a while. Expired limits are treated as removed.",
+
+		CreateContext: resourceGithubOrganizationInteractionLimitCreateOrUpdate,
+		ReadContext:   resourceGithubOrganizationInteractionLimitRead,
+		UpdateContext: resourceGithubOrganizationInteractionLimitCreateOrUpdate,
+		DeleteContext: resourceGithubOrganizationInteractionLimitDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubOrganizationInteractionLimitImport,
+		},
+
+		Schema: interactionLimitSchema("public repositories of the organization"),
+	}
+}
+
+func resourceGithubOrganizationInteractionLimitCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	if err = setInteractionLimit(ctx, client, fmt.Sprintf("orgs/%s/interaction-limits", orgName), d); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting the interaction limit of organization %s: %w", orgName, err))
+	}
+	d.SetId(orgName)
+
+	return resourceGithubOrganizationInteractionLimitRead(ctx, d, meta)
+}
+
+func resourceGithubOrganizationInteractionLimitRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	restriction, _, err := client.Interactions.GetRestrictionsForOrg(ctx, orgName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	active, err := flattenInteractionLimit(d, restriction, "organization")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !active {
+		log.Printf("[INFO] Removing interaction limit of organization %s from state because it expired or was removed", orgName)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationInteractionLimitDelete(ctx context.Context, _ *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	if _, err = client.Interactions.RemoveRestrictionsFromOrg(ctx, orgName); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubOrganizationInteractionLimitImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	// The expiry isn't returned by GitHub.
+	if err := d.Set("expiry", "one_day"); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,35 @@
+package github
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubOrganizationInteractionLimit(t *testing.T) {
+	t.Run("limits interactions with the repositories of an organization", func(t *testing.T) {
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "github_organization_interaction_limit" "test" {
+							limit  = "existing_users"
+							expiry = "one_day"
+						}
+					`,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_organization_interaction_limit.test", "limit", "existing_users"),
+						resource.TestCheckResourceAttrSet("github_organization_interaction_limit.test", "expires_at"),
+					),
+				},
+				{
+					ResourceName:      "github_organization_interaction_limit.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
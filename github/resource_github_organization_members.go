@@ -0,0 +1,322 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// organizationMember is a member of an organization, or a user invited to become one.
+type organizationMember struct {
+	username string
+	role     string
+	pending  bool
+}
+
+func resourceGithubOrganizationMembers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the members of an organization. Members and invitations not managed by Terraform are removed.",
+
+		CreateContext: resourceGithubOrganizationMembersCreateOrUpdate,
+		ReadContext:   resourceGithubOrganizationMembersRead,
+		UpdateContext: resourceGithubOrganizationMembersCreateOrUpdate,
+		DeleteContext: resourceGithubOrganizationMembersDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceGithubOrganizationMembersDiff,
+
+		Schema: map[string]*schema.Schema{
+			"members": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The members of the organization. Users who aren't members yet are invited.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: caseInsensitive(),
+							Description:      "The user to add to the organization.",
+						},
+						"role": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "member",
+							Description:      "The role of the user within the organization. Must be one of 'member' or 'admin'.",
+							ValidateDiagFunc: validateValueFunc([]string{"member", "admin"}),
+						},
+					},
+				},
+			},
+			"protected_users": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Users who are never removed from the organization, even if they aren't in 'members'.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"max_removals_per_apply": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          5,
+				Description:      "The maximum number of members and invitations removed by a single apply. No members are removed if more would be. '0' means no limit.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"pending_invitations": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The members who haven't accepted their invitation to the organization yet.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceGithubOrganizationMembersCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	// The membership may have changed since the plan, so the removals are checked again before anyone is removed.
+	current, err := listOrganizationMembers(ctx, client, orgName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	desired := expandOrganizationMembers(d.Get("members").(*schema.Set))
+	protected := lowercaseStringSet(d.Get("protected_users").(*schema.Set))
+
+	removals, err := organizationMemberRemovals(ctx, client, current, desired, protected, d.Get("max_removals_per_apply").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for _, username := range removals {
+		log.Printf("[DEBUG] Removing %s from organization %s", username, orgName)
+		if _, err = client.Organizations.RemoveOrgMembership(ctx, username, orgName); err != nil {
+			return diag.FromErr(fmt.Errorf("error removing %s from organization %s: %w", username, orgName, err))
+		}
+	}
+
+	for key, member := range desired {
+		if existing, ok := current[key]; ok && existing.role == member.role {
+			continue
+		}
+		log.Printf("[DEBUG] Setting the membership of %s in organization %s to %s", member.username, orgName, member.role)
+		_, _, err = client.Organizations.EditOrgMembership(ctx, member.username, orgName, &github.Membership{Role: new(member.role)})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error setting the membership of %s in organization %s: %w", member.username, orgName, err))
+		}
+	}
+
+	d.SetId(orgName)
+
+	return resourceGithubOrganizationMembersRead(ctx, d, meta)
+}
+
+func resourceGithubOrganizationMembersRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	err := checkOrganization(meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(*Owner).v3client
+	orgName := meta.(*Owner).name
+
+	current, err := listOrganizationMembers(ctx, client, orgName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Protected users who aren't managed aren't drift, as they are never removed. The case of the configured usernames
+	// is kept.
+	configured := expandOrganizationMembers(d.Get("members").(*schema.Set))
+	protected := lowercaseStringSet(d.Get("protected_users").(*schema.Set))
+
+	members := make([]any, 0, len(current))
+	pending := make([]any, 0)
+	for key, member := range current {
+		username := member.username
+		if c, ok := configured[key]; ok {
+			username = c.username
+		} else if protected[key] {
+			continue
+		}
+
+		members = append(members, map[string]any{
+			"username": username,
+			"role":     member.role,
+		})
+		if member.pending {
+			pending = append(pending, username)
+		}
+	}
+
+	if err = d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("pending_invitations", pending); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceGithubOrganizationMembersDelete only stops managing the members, as removing them all from the organization
+// on destroy, or when the resource is renamed, would lock everyone out.
+func resourceGithubOrganizationMembersDelete(_ context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	log.Printf("[INFO] Removing the members of organization %s from state, they remain members of the organization", meta.(*Owner).name)
+	d.SetId("")
+	return nil
+}
+
+// resourceGithubOrganizationMembersDiff checks the removals against the current members of the organization, so
+// plans which would remove too many members, the authenticated user or the last admin fail before they are applied.
+func resourceGithubOrganizationMembersDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChange("members") || !d.NewValueKnown("members") || !d.NewValueKnown("protected_users") || !d.NewValueKnown("max_removals_per_apply") {
+		return nil
+	}
+	if err := checkOrganization(meta); err != nil {
+		return err
+	}
+
+	client := meta.(*Owner).v3client
+	current, err := listOrganizationMembers(ctx, client, meta.(*Owner).name)
+	if err != nil {
+		return err
+	}
+	desired := expandOrganizationMembers(d.Get("members").(*schema.Set))
+	protected := lowercaseStringSet(d.Get("protected_users").(*schema.Set))
+
+	_, err = organizationMemberRemovals(ctx, client, current, desired, protected, d.Get("max_removals_per_apply").(int))
+	return err
+}
+
+// organizationMemberRemovals returns the current members and invitations which are neither configured nor protected.
+// It returns an error if more would be removed than allowed, or if the authenticated user or the last admin would be.
+func organizationMemberRemovals(ctx context.Context, client *github.Client, current, desired map[string]organizationMember, protected map[string]bool, maxRemovals int) ([]string, error) {
+	var removals []string
+	keptAdmins := 0
+	for key, member := range current {
+		role := member.role
+		if configured, ok := desired[key]; ok {
+			role = configured.role
+		} else if !protected[key] {
+			removals = append(removals, member.username)
+			continue
+		}
+		if member.role == "admin" && role == "admin" && !member.pending {
+			keptAdmins++
+		}
+	}
+	slices.Sort(removals)
+
+	if maxRemovals > 0 && len(removals) > maxRemovals {
+		return nil, fmt.Errorf("refusing to remove %d members from the organization, max_removals_per_apply is %d: %s",
+			len(removals), maxRemovals, strings.Join(removals, ", "))
+	}
+	if keptAdmins == 0 {
+		return nil, fmt.Errorf("refusing to remove the last admin of the organization, at least one current admin must stay an admin")
+	}
+	if len(removals) == 0 {
+		return removals, nil
+	}
+
+	user, _, err := client.Users.Get(ctx, "")
+	if err != nil {
+		// GitHub App installations aren't users, so they aren't members of the organization.
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusForbidden {
+			return removals, nil
+		}
+		return nil, err
+	}
+	if slices.ContainsFunc(removals, func(username string) bool { return strings.EqualFold(username, user.GetLogin()) }) {
+		return nil, fmt.Errorf("refusing to remove the authenticated user %s from the organization, add them to members or protected_users", user.GetLogin())
+	}
+
+	return removals, nil
+}
+
+// listOrganizationMembers returns the members of an organization and the users with a pending invitation to become
+// one, keyed by lowercased username. Invitations by email and to other roles than members and admins are ignored.
+func listOrganizationMembers(ctx context.Context, client *github.Client, orgName string) (map[string]organizationMember, error) {
+	members := make(map[string]organizationMember)
+
+	for _, role := range []string{"admin", "member"} {
+		opts := &github.ListMembersOptions{Role: role, ListOptions: github.ListOptions{PerPage: maxPerPage}}
+		for {
+			users, resp, err := client.Organizations.ListMembers(ctx, orgName, opts)
+			if err != nil {
+				return nil, err
+			}
+			for _, user := range users {
+				members[strings.ToLower(user.GetLogin())] = organizationMember{username: user.GetLogin(), role: role}
+			}
+
+			if resp.NextPage == 0 {
+				break
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		invitations, resp, err := client.Organizations.ListPendingOrgInvitations(ctx, orgName, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, invitation := range invitations {
+			var role string
+			switch invitation.GetRole() {
+			case "admin":
+				role = "admin"
+			case "direct_member":
+				role = "member"
+			}
+			if invitation.GetLogin() == "" || role == "" {
+				continue
+			}
+			members[strings.ToLower(invitation.GetLogin())] = organizationMember{username: invitation.GetLogin(), role: role, pending: true}
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return members, nil
+}
+
+func expandOrganizationMembers(set *schema.Set) map[string]organizationMember {
+	members := make(map[string]organizationMember, set.Len())
+	for _, v := range set.List() {
+		m := v.(map[string]any)
+		username := m["username"].(string)
+		members[strings.ToLower(username)] = organizationMember{username: username, role: m["role"].(string)}
+	}
+	return members
+}
+
+func lowercaseStringSet(set *schema.Set) map[string]bool {
+	result := make(map[string]bool, set.Len())
+	for _, v := range set.List() {
+		result[strings.ToLower(v.(string))] = true
+	}
+	return result
+}
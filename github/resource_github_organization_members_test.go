@@ -0,0 +1,262 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func newOrganizationMembersTestOwner(t *testing.T) (*Owner, *[]string) {
+	var mu sync.Mutex
+	var requests []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orgs/test-org/members", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("role") == "admin" {
+			mustWrite(w, `[{"login": "Alice"}]`)
+			return
+		}
+		mustWrite(w, `[{"login": "bob"}, {"login": "carol"}, {"login": "mallory"}]`)
+	})
+	mux.HandleFunc("GET /orgs/test-org/invitations", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `[
+				{"id": 1, "login": "dave", "role": "direct_member"},
+				{"id": 2, "email": "eve@example.com", "role": "direct_member"},
+				{"id": 3, "login": "frank", "role": "billing_manager"}
+			]`)
+	})
+	mux.HandleFunc("PUT /orgs/test-org/memberships/{username}", func(w http.ResponseWriter, r *http.Request) {
+		var body github.Membership
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		requests = append(requests, fmt.Sprintf("PUT %s %s", r.PathValue("username"), body.GetRole()))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{}`)
+	})
+	mux.HandleFunc("DELETE /orgs/test-org/memberships/{username}", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, "DELETE "+r.PathValue("username"))
+		mu.Unlock()
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	mux.HandleFunc("GET /user", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"login": "Mallory"}`)
+	})
+
+	return newTestOwner(t, mux, "test-org", true), &requests
+}
+
+func TestGithubOrganizationMembersCreate(t *testing.T) {
+	newMembers := func(usernames ...string) []any {
+		members := []any{
+			map[string]any{"username": "alice", "role": "admin"},
+			map[string]any{"username": "bob", "role": "admin"},
+			map[string]any{"username": "erin", "role": "member"},
+		}
+		for _, username := range usernames {
+			members = append(members, map[string]any{"username": username, "role": "member"})
+		}
+		return members
+	}
+	newData := func(t *testing.T, maxRemovals int, usernames ...string) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceGithubOrganizationMembers().Schema, map[string]any{
+			"members":                newMembers(usernames...),
+			"protected_users":        []any{"Carol"},
+			"max_removals_per_apply": maxRemovals,
+		})
+	}
+
+	t.Run("reconciles the members", func(t *testing.T) {
+		meta, requests := newOrganizationMembersTestOwner(t)
+		d := newData(t, 2, "mallory")
+
+		if diags := resourceGithubOrganizationMembersCreateOrUpdate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		got := slices.Clone(*requests)
+		slices.Sort(got)
+		if want := []string{"DELETE dave", "PUT bob admin", "PUT erin member"}; !slices.Equal(got, want) {
+			t.Errorf("got requests %v; want %v", got, want)
+		}
+
+		// The mocked members aren't updated. The protected user isn't read back, and the configured case is kept.
+		var members []string
+		for _, v := range d.Get("members").(*schema.Set).List() {
+			m := v.(map[string]any)
+			members = append(members, fmt.Sprintf("%s:%s", m["username"], m["role"]))
+		}
+		slices.Sort(members)
+		if want := []string{"alice:admin", "bob:member", "dave:member", "mallory:member"}; !slices.Equal(members, want) {
+			t.Errorf("got members %v; want %v", members, want)
+		}
+		if pending := d.Get("pending_invitations").(*schema.Set); pending.Len() != 1 || !pending.Contains("dave") {
+			t.Errorf("got pending invitations %v; want [dave]", pending.List())
+		}
+	})
+
+	t.Run("refuses to remove more members than allowed", func(t *testing.T) {
+		meta, requests := newOrganizationMembersTestOwner(t)
+		d := newData(t, 1)
+
+		diags := resourceGithubOrganizationMembersCreateOrUpdate(t.Context(), d, meta)
+		if !diags.HasError() {
+			t.Fatal("expected an error")
+		}
+		if !strings.Contains(diags[0].Summary, "refusing to remove 2 members from the organization, max_removals_per_apply is 1: dave, mallory") {
+			t.Errorf("got error %q", diags[0].Summary)
+		}
+		if len(*requests) > 0 {
+			t.Errorf("expected no changes, got requests %v", *requests)
+		}
+	})
+
+	t.Run("refuses to remove the authenticated user", func(t *testing.T) {
+		meta, requests := newOrganizationMembersTestOwner(t)
+		d := newData(t, 0)
+
+		diags := resourceGithubOrganizationMembersCreateOrUpdate(t.Context(), d, meta)
+		if !diags.HasError() || !strings.Contains(diags[0].Summary, "refusing to remove the authenticated user Mallory") {
+			t.Fatalf("got %v; want an error about the authenticated user", diags)
+		}
+		if len(*requests) > 0 {
+			t.Errorf("expected no changes, got requests %v", *requests)
+		}
+	})
+}
+
+func TestGithubOrganizationMembersDiff(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		members     []any
+		maxRemovals int
+		wantErr     string
+	}{
+		{
+			name: "plans the removals on create",
+			members: []any{
+				map[string]any{"username": "alice", "role": "admin"},
+				map[string]any{"username": "mallory"},
+			},
+			maxRemovals: 5,
+		},
+		{
+			name: "refuses to remove more members than allowed on create",
+			members: []any{
+				map[string]any{"username": "alice", "role": "admin"},
+				map[string]any{"username": "mallory"},
+			},
+			maxRemovals: 1,
+			wantErr:     "refusing to remove 2 members from the organization, max_removals_per_apply is 1: bob, dave",
+		},
+		{
+			name: "refuses to remove the authenticated user",
+			members: []any{
+				map[string]any{"username": "alice", "role": "admin"},
+			},
+			maxRemovals: 5,
+			wantErr:     "refusing to remove the authenticated user Mallory from the organization",
+		},
+		{
+			name: "refuses to remove the last admin",
+			members: []any{
+				map[string]any{"username": "alice", "role": "member"},
+				map[string]any{"username": "bob", "role": "admin"},
+				map[string]any{"username": "dave", "role": "admin"},
+				map[string]any{"username": "mallory"},
+			},
+			maxRemovals: 5,
+			wantErr:     "refusing to remove the last admin of the organization",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			meta, _ := newOrganizationMembersTestOwner(t)
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				"members":                tt.members,
+				"protected_users":        []any{"carol"},
+				"max_removals_per_apply": tt.maxRemovals,
+			})
+
+			_, err := resourceGithubOrganizationMembers().Diff(t.Context(), nil, config, meta)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("got error %v; want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGithubOrganizationMembersDelete(t *testing.T) {
+	meta, requests := newOrganizationMembersTestOwner(t)
+	d := schema.TestResourceDataRaw(t, resourceGithubOrganizationMembers().Schema, map[string]any{
+		"members": []any{map[string]any{"username": "bob", "role": "member"}},
+	})
+	d.SetId("test-org")
+
+	if diags := resourceGithubOrganizationMembersDelete(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(*requests) > 0 {
+		t.Errorf("expected the members to be kept, got requests %v", *requests)
+	}
+}
+
+func TestAccGithubOrganizationMembers(t *testing.T) {
+	t.Run("manages the members of an organization", func(t *testing.T) {
+		config := fmt.Sprintf(`
+			resource "github_organization_members" "test" {
+				members {
+					username = "%s"
+					role     = "admin"
+				}
+
+				members {
+					username = "%s"
+				}
+
+				protected_users        = ["%[1]s"]
+				max_removals_per_apply = 1
+			}
+		`, testAccConf.username, testAccConf.testOrgUser)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessMode(t, organization) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_organization_members.test", "members.#", "2"),
+					),
+				},
+				{
+					ResourceName:            "github_organization_members.test",
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"protected_users", "max_removals_per_apply"},
+				},
+			},
+		})
+	})
+}
@@ -727,6 +727,66 @@ resource "github_organization_ruleset" "test" {
 		})
 	})
 
+	t.Run("creates_ruleset_targeting_repository_property", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		propertyName := fmt.Sprintf("%stier-%s", testResourcePrefix, randomID)
+		rulesetName := fmt.Sprintf("%stest-property-%s", testResourcePrefix, randomID)
+		resourceFullName := "github_organization_ruleset.test"
+		config := fmt.Sprintf(`
+			resource "github_organization_custom_properties" "test" {
+				property_name  = "%s"
+				value_type     = "single_select"
+				allowed_values = ["critical", "standard"]
+			}
+
+			resource "github_organization_ruleset" "test" {
+				name        = "%s"
+				target      = "branch"
+				enforcement = "active"
+
+				conditions {
+					ref_name {
+						include = ["~DEFAULT_BRANCH"]
+						exclude = []
+					}
+
+					repository_property {
+						include {
+							name            = github_organization_custom_properties.test.property_name
+							property_values = ["critical"]
+						}
+					}
+				}
+
+				rules {
+					deletion = true
+				}
+			}
+		`, propertyName, rulesetName)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasPaidOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr(resourceFullName, "conditions.0.repository_property.0.include.0.name", propertyName),
+						resource.TestCheckResourceAttr(resourceFullName, "conditions.0.repository_property.0.include.0.property_values.0", "critical"),
+						resource.TestCheckResourceAttr(resourceFullName, "conditions.0.repository_property.0.include.0.source", "custom"),
+						resource.TestCheckResourceAttr(resourceFullName, "conditions.0.repository_property.0.exclude.#", "0"),
+					),
+				},
+				{
+					ResourceName:            resourceFullName,
+					ImportState:             true,
+					ImportStateVerify:       true,
+					ImportStateVerifyIgnore: []string{"etag"},
+				},
+			},
+		})
+	})
+
 	t.Run("validates_rules__required_status_checks_block", func(t *testing.T) {
 		t.Run("required_check__context_block_should_not_be_empty", func(t *testing.T) {
 			resourceName := "test-required-status-checks-context-is-not-empty"
@@ -90,6 +90,9 @@ func resourceGithubOrganizationWebhookCreate(ctx context.Context, d *schema.Reso
 
 	orgName := meta.(*Owner).name
 	webhookObj := resourceGithubOrganizationWebhookObject(d)
+	if err := expandWebhookWriteOnlySecret(d, webhookObj); err != nil {
+		return diag.FromErr(err)
+	}
 
 	hook, _, err := client.Organizations.CreateHook(ctx, orgName, webhookObj)
 	if err != nil {
@@ -97,14 +100,7 @@ func resourceGithubOrganizationWebhookCreate(ctx context.Context, d *schema.Reso
 	}
 	d.SetId(strconv.FormatInt(hook.GetID(), 10))
 
-	// GitHub returns the secret as a string of 8 astrisks "********"
-	// We would prefer to store the real secret in state, so we'll
-	// write the configuration secret in state from our request to GitHub
-	if hook.Config.Secret != nil {
-		hook.Config.Secret = webhookObj.Config.Secret
-	}
-
-	if err = d.Set("configuration", interfaceFromWebhookConfig(hook.Config)); err != nil {
+	if err = d.Set("configuration", flattenWebhookConfiguration(d, hook.Config)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -159,19 +155,7 @@ func resourceGithubOrganizationWebhookRead(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	// GitHub returns the secret as a string of 8 astrisks "********"
-	// We would prefer to store the real secret in state, so we'll
-	// write the configuration secret in state from what we get from
-	// ResourceData
-	if len(d.Get("configuration").([]any)) > 0 {
-		currentSecret := d.Get("configuration").([]any)[0].(map[string]any)["secret"]
-
-		if hook.Config.Secret != nil {
-			hook.Config.Secret = new(currentSecret.(string))
-		}
-	}
-
-	if err = d.Set("configuration", interfaceFromWebhookConfig(hook.Config)); err != nil {
+	if err = d.Set("configuration", flattenWebhookConfiguration(d, hook.Config)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -188,6 +172,9 @@ func resourceGithubOrganizationWebhookUpdate(ctx context.Context, d *schema.Reso
 
 	orgName := meta.(*Owner).name
 	webhookObj := resourceGithubOrganizationWebhookObject(d)
+	if err := expandWebhookWriteOnlySecret(d, webhookObj); err != nil {
+		return diag.FromErr(err)
+	}
 	hookID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return diag.FromErr(unconvertibleIdErr(d.Id(), err))
@@ -0,0 +1,190 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the project.",
+			},
+			"short_description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the project.",
+			},
+			"readme": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The README of the project, in Markdown.",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the project is visible to anyone.",
+			},
+			"closed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the project is closed.",
+			},
+			"number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the project.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the project.",
+			},
+		},
+
+		CreateContext: resourceGithubProjectV2Create,
+		ReadContext:   resourceGithubProjectV2Read,
+		UpdateContext: resourceGithubProjectV2Update,
+		DeleteContext: resourceGithubProjectV2Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceGithubProjectV2Create(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	meta := m.(*Owner)
+	client := meta.v4client
+
+	ownerID, err := getProjectV2OwnerID(ctx, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var mutation struct {
+		CreateProjectV2 struct {
+			ProjectV2 struct {
+				ID githubv4.ID
+			}
+		} `graphql:"createProjectV2(input:$input)"`
+	}
+	input := githubv4.CreateProjectV2Input{
+		OwnerID: ownerID,
+		Title:   githubv4.String(d.Get("title").(string)),
+	}
+
+	if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(mutation.CreateProjectV2.ProjectV2.ID.(string))
+
+	// The remaining attributes can only be set once the project exists.
+	if err := updateProjectV2(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubProjectV2Read(ctx, d, m)
+}
+
+func resourceGithubProjectV2Read(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	meta := m.(*Owner)
+	client := meta.v4client
+
+	var query struct {
+		Node struct {
+			ProjectV2 projectV2Fragment `graphql:"... on ProjectV2"`
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]any{
+		"id": githubv4.ID(d.Id()),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if isGraphQLNotFound(err) || (err == nil && query.Node.ProjectV2.ID == nil) {
+		log.Printf("[INFO] Removing project %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	project := query.Node.ProjectV2
+	if err := d.Set("title", string(project.Title)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("short_description", string(project.ShortDescription)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("readme", string(project.Readme)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("public", bool(project.Public)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("closed", bool(project.Closed)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("number", int(project.Number)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", string(project.URL)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubProjectV2Update(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if err := updateProjectV2(ctx, d, m.(*Owner)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubProjectV2Read(ctx, d, m)
+}
+
+func resourceGithubProjectV2Delete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var mutation struct {
+		DeleteProjectV2 struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"deleteProjectV2(input:$input)"`
+	}
+	input := githubv4.DeleteProjectV2Input{
+		ProjectID: githubv4.ID(d.Id()),
+	}
+
+	err := client.Mutate(ctx, &mutation, input, nil)
+	if isGraphQLNotFound(err) {
+		return nil
+	}
+	return diag.FromErr(err)
+}
+
+func updateProjectV2(ctx context.Context, d *schema.ResourceData, meta *Owner) error {
+	var mutation struct {
+		UpdateProjectV2 struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"updateProjectV2(input:$input)"`
+	}
+	input := githubv4.UpdateProjectV2Input{
+		ProjectID:        githubv4.ID(d.Id()),
+		Title:            new(githubv4.String(d.Get("title").(string))),
+		ShortDescription: new(githubv4.String(d.Get("short_description").(string))),
+		Readme:           new(githubv4.String(d.Get("readme").(string))),
+		Public:           new(githubv4.Boolean(d.Get("public").(bool))),
+		Closed:           new(githubv4.Boolean(d.Get("closed").(bool))),
+	}
+
+	return meta.v4client.Mutate(ctx, &mutation, input, nil)
+}
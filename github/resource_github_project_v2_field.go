@@ -0,0 +1,421 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2Field() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The node ID of the project.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the field.",
+			},
+			"data_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+					string(githubv4.ProjectV2CustomFieldTypeText),
+					string(githubv4.ProjectV2CustomFieldTypeNumber),
+					string(githubv4.ProjectV2CustomFieldTypeDate),
+					string(githubv4.ProjectV2CustomFieldTypeSingleSelect),
+					string(githubv4.ProjectV2CustomFieldTypeIteration),
+				}, false)),
+				Description: "The data type of the field. Must be one of 'TEXT', 'NUMBER', 'DATE', 'SINGLE_SELECT' or 'ITERATION'.",
+			},
+			"single_select_option": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The options of a 'SINGLE_SELECT' field.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the option.",
+						},
+						"color": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  string(githubv4.ProjectV2SingleSelectFieldOptionColorGray),
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorGray),
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorBlue),
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorGreen),
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorYellow),
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorOrange),
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorRed),
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorPink),
+								string(githubv4.ProjectV2SingleSelectFieldOptionColorPurple),
+							}, false)),
+							Description: "The color of the option.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "The description of the option.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the option.",
+						},
+					},
+				},
+			},
+			"iteration_configuration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "The configuration of an 'ITERATION' field.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_date": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in YYYY-MM-DD format")),
+							Description:      "The start date of the first iteration, in 'YYYY-MM-DD' format.",
+						},
+						"duration": {
+							Type:             schema.TypeInt,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+							Description:      "The duration of each iteration, in days.",
+						},
+					},
+				},
+			},
+			"iterations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The active and upcoming iterations of an 'ITERATION' field.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the iteration.",
+						},
+						"title": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The title of the iteration.",
+						},
+						"start_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The start date of the iteration.",
+						},
+						"duration": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The duration of the iteration, in days.",
+						},
+					},
+				},
+			},
+			"database_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The database ID of the field.",
+			},
+		},
+
+		CustomizeDiff: resourceGithubProjectV2FieldCustomizeDiff,
+
+		CreateContext: resourceGithubProjectV2FieldCreate,
+		ReadContext:   resourceGithubProjectV2FieldRead,
+		UpdateContext: resourceGithubProjectV2FieldUpdate,
+		DeleteContext: resourceGithubProjectV2FieldDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceGithubProjectV2FieldCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	dataType := githubv4.ProjectV2CustomFieldType(d.Get("data_type").(string))
+
+	if dataType != githubv4.ProjectV2CustomFieldTypeSingleSelect && len(d.Get("single_select_option").([]any)) > 0 {
+		return fmt.Errorf("single_select_option can only be set for fields with data_type %q", githubv4.ProjectV2CustomFieldTypeSingleSelect)
+	}
+	if dataType == githubv4.ProjectV2CustomFieldTypeSingleSelect && len(d.Get("single_select_option").([]any)) == 0 {
+		return fmt.Errorf("at least one single_select_option is required for fields with data_type %q", githubv4.ProjectV2CustomFieldTypeSingleSelect)
+	}
+	if dataType != githubv4.ProjectV2CustomFieldTypeIteration && len(d.Get("iteration_configuration").([]any)) > 0 {
+		return fmt.Errorf("iteration_configuration can only be set for fields with data_type %q", githubv4.ProjectV2CustomFieldTypeIteration)
+	}
+
+	return nil
+}
+
+func resourceGithubProjectV2FieldCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var mutation struct {
+		CreateProjectV2Field struct {
+			ProjectV2Field struct {
+				Common struct {
+					ID githubv4.ID
+				} `graphql:"... on ProjectV2FieldCommon"`
+			}
+		} `graphql:"createProjectV2Field(input:$input)"`
+	}
+	input := githubv4.CreateProjectV2FieldInput{
+		ProjectID: githubv4.ID(d.Get("project_id").(string)),
+		DataType:  githubv4.ProjectV2CustomFieldType(d.Get("data_type").(string)),
+		Name:      githubv4.String(d.Get("name").(string)),
+	}
+
+	switch input.DataType {
+	case githubv4.ProjectV2CustomFieldTypeSingleSelect:
+		input.SingleSelectOptions = new(expandProjectV2SingleSelectOptions(d.Get("single_select_option").([]any)))
+	case githubv4.ProjectV2CustomFieldTypeIteration:
+		configuration, err := expandProjectV2IterationConfiguration(d.Get("iteration_configuration").([]any), nil)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		input.IterationConfiguration = configuration
+	}
+
+	if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(mutation.CreateProjectV2Field.ProjectV2Field.Common.ID.(string))
+
+	return resourceGithubProjectV2FieldRead(ctx, d, m)
+}
+
+func resourceGithubProjectV2FieldRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var query struct {
+		Node struct {
+			Common struct {
+				Project struct {
+					ID githubv4.ID
+				}
+			} `graphql:"... on ProjectV2FieldCommon"`
+			projectV2FieldFragment
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]any{
+		"id": githubv4.ID(d.Id()),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if isGraphQLNotFound(err) || (err == nil && query.Node.Common.Project.ID == nil) {
+		log.Printf("[INFO] Removing project field %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	field := query.Node.flatten()
+	if err := d.Set("project_id", query.Node.Common.Project.ID.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", field.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("data_type", field.DataType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("database_id", field.DatabaseID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("single_select_option", field.Options); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("iterations", field.Iterations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if field.DataType == string(githubv4.ProjectV2CustomFieldTypeIteration) {
+		// GitHub only reports the current and upcoming iterations, so the configured start date is kept
+		// unless the field is being imported.
+		startDate := ""
+		if configuration := d.Get("iteration_configuration").([]any); len(configuration) > 0 && configuration[0] != nil {
+			startDate = configuration[0].(map[string]any)["start_date"].(string)
+		} else if len(field.Iterations) > 0 {
+			startDate = field.Iterations[0]["start_date"].(string)
+		}
+
+		if err := d.Set("iteration_configuration", []any{map[string]any{
+			"start_date": startDate,
+			"duration":   field.IterationDuration,
+		}}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceGithubProjectV2FieldUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var mutation struct {
+		UpdateProjectV2Field struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"updateProjectV2Field(input:$input)"`
+	}
+	input := UpdateProjectV2FieldInput{
+		FieldID: githubv4.ID(d.Id()),
+		Name:    new(githubv4.String(d.Get("name").(string))),
+	}
+
+	// The options and iterations sent replace the existing ones, so existing options keep their IDs, and with them
+	// the values set on items, and the existing iterations are sent along with the new configuration.
+	if d.HasChange("single_select_option") {
+		o, n := d.GetChange("single_select_option")
+		input.SingleSelectOptions = new(expandProjectV2SingleSelectOptionUpdates(o.([]any), n.([]any)))
+	}
+	if d.HasChange("iteration_configuration") {
+		configuration, err := expandProjectV2IterationConfiguration(d.Get("iteration_configuration").([]any), d.Get("iterations").([]any))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		input.IterationConfiguration = configuration
+	}
+
+	if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubProjectV2FieldRead(ctx, d, m)
+}
+
+func resourceGithubProjectV2FieldDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var mutation struct {
+		DeleteProjectV2Field struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"deleteProjectV2Field(input:$input)"`
+	}
+	input := githubv4.DeleteProjectV2FieldInput{
+		FieldID: githubv4.ID(d.Id()),
+	}
+
+	err := client.Mutate(ctx, &mutation, input, nil)
+	if isGraphQLNotFound(err) {
+		return nil
+	}
+	return diag.FromErr(err)
+}
+
+func expandProjectV2SingleSelectOptions(options []any) []githubv4.ProjectV2SingleSelectFieldOptionInput {
+	result := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0, len(options))
+	for _, o := range options {
+		option := o.(map[string]any)
+		result = append(result, githubv4.ProjectV2SingleSelectFieldOptionInput{
+			Name:        githubv4.String(option["name"].(string)),
+			Color:       githubv4.ProjectV2SingleSelectFieldOptionColor(option["color"].(string)),
+			Description: githubv4.String(option["description"].(string)),
+		})
+	}
+	return result
+}
+
+// expandProjectV2SingleSelectOptionUpdates returns the options to update a field with. Options are matched to the
+// existing ones by name, or else by position, so renamed options keep their ID.
+func expandProjectV2SingleSelectOptionUpdates(oldOptions, newOptions []any) []projectV2SingleSelectFieldOptionInput {
+	ids := make(map[string]string, len(oldOptions))
+	for _, o := range oldOptions {
+		option := o.(map[string]any)
+		ids[option["name"].(string)] = option["id"].(string)
+	}
+	names := make(map[string]bool, len(newOptions))
+	for _, o := range newOptions {
+		names[o.(map[string]any)["name"].(string)] = true
+	}
+
+	result := make([]projectV2SingleSelectFieldOptionInput, 0, len(newOptions))
+	for i, input := range expandProjectV2SingleSelectOptions(newOptions) {
+		id, ok := ids[string(input.Name)]
+		if !ok && i < len(oldOptions) {
+			if old := oldOptions[i].(map[string]any); !names[old["name"].(string)] {
+				id = old["id"].(string)
+			}
+		}
+
+		option := projectV2SingleSelectFieldOptionInput{ProjectV2SingleSelectFieldOptionInput: input}
+		if id != "" {
+			option.ID = new(githubv4.ID(id))
+		}
+		result = append(result, option)
+	}
+	return result
+}
+
+// expandProjectV2IterationConfiguration returns the iteration configuration, with the existing iterations as they
+// would otherwise be removed.
+func expandProjectV2IterationConfiguration(configuration, iterations []any) (*githubv4.ProjectV2IterationFieldConfigurationInput, error) {
+	if len(configuration) == 0 || configuration[0] == nil {
+		return nil, nil
+	}
+
+	c := configuration[0].(map[string]any)
+	startDate, err := time.Parse(time.DateOnly, c["start_date"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("invalid iteration start_date %q: %w", c["start_date"], err)
+	}
+
+	input := &githubv4.ProjectV2IterationFieldConfigurationInput{
+		StartDate:  githubv4.Date{Time: startDate},
+		Duration:   githubv4.Int(c["duration"].(int)),
+		Iterations: make([]githubv4.ProjectV2Iteration, 0, len(iterations)),
+	}
+	for _, i := range iterations {
+		iteration := i.(map[string]any)
+		startDate, err := time.Parse(time.DateOnly, iteration["start_date"].(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date %q of iteration %s: %w", iteration["start_date"], iteration["id"], err)
+		}
+		input.Iterations = append(input.Iterations, githubv4.ProjectV2Iteration{
+			StartDate: githubv4.Date{Time: startDate},
+			Duration:  githubv4.Int(iteration["duration"].(int)),
+			Title:     githubv4.String(iteration["title"].(string)),
+		})
+	}
+
+	return input, nil
+}
+
+// UpdateProjectV2FieldInput is githubv4.UpdateProjectV2FieldInput with the IDs of the single select options. It's
+// exported as the GraphQL input type is named after the Go type.
+type UpdateProjectV2FieldInput struct {
+	FieldID                githubv4.ID                                         `json:"fieldId"`
+	Name                   *githubv4.String                                    `json:"name,omitempty"`
+	SingleSelectOptions    *[]projectV2SingleSelectFieldOptionInput            `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *githubv4.ProjectV2IterationFieldConfigurationInput `json:"iterationConfiguration,omitempty"`
+}
+
+// projectV2SingleSelectFieldOptionInput is githubv4.ProjectV2SingleSelectFieldOptionInput with the ID of an existing
+// option, which keeps the option rather than replacing it.
+type projectV2SingleSelectFieldOptionInput struct {
+	githubv4.ProjectV2SingleSelectFieldOptionInput
+	ID *githubv4.ID `json:"id,omitempty"`
+}
@@ -0,0 +1,398 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2Item() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The node ID of the project.",
+			},
+			"content_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The node ID of the issue or pull request to add to the project.",
+			},
+			"archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the item is archived.",
+			},
+			"field_value": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The values of the item's fields. Only the fields listed here are managed.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The node ID of the field.",
+						},
+						"text": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The value of a 'TEXT' field.",
+						},
+						"number": {
+							Type:        schema.TypeFloat,
+							Optional:    true,
+							Description: "The value of a 'NUMBER' field.",
+						},
+						"date": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in YYYY-MM-DD format")),
+							Description:      "The value of a 'DATE' field, in 'YYYY-MM-DD' format.",
+						},
+						"single_select_option_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the selected option of a 'SINGLE_SELECT' field.",
+						},
+						"iteration_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The ID of the selected iteration of an 'ITERATION' field.",
+						},
+					},
+				},
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the item's content, e.g. 'ISSUE' or 'PULL_REQUEST'.",
+			},
+		},
+
+		CreateContext: resourceGithubProjectV2ItemCreate,
+		ReadContext:   resourceGithubProjectV2ItemRead,
+		UpdateContext: resourceGithubProjectV2ItemUpdate,
+		DeleteContext: resourceGithubProjectV2ItemDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceGithubProjectV2ItemCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var mutation struct {
+		AddProjectV2ItemByID struct {
+			Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addProjectV2ItemById(input:$input)"`
+	}
+	input := githubv4.AddProjectV2ItemByIdInput{
+		ProjectID: githubv4.ID(d.Get("project_id").(string)),
+		ContentID: githubv4.ID(d.Get("content_id").(string)),
+	}
+
+	if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(mutation.AddProjectV2ItemByID.Item.ID.(string))
+
+	if err := updateProjectV2Item(ctx, d, m.(*Owner)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubProjectV2ItemRead(ctx, d, m)
+}
+
+func resourceGithubProjectV2ItemRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var query struct {
+		Node struct {
+			ProjectV2Item struct {
+				ID         githubv4.ID
+				Type       githubv4.String
+				IsArchived githubv4.Boolean
+				Project    struct {
+					ID githubv4.ID
+				}
+				Content struct {
+					Node struct {
+						ID githubv4.ID
+					} `graphql:"... on Node"`
+				}
+				FieldValues struct {
+					Nodes []projectV2ItemFieldValueFragment
+				} `graphql:"fieldValues(first:100)"`
+			} `graphql:"... on ProjectV2Item"`
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]any{
+		"id": githubv4.ID(d.Id()),
+	}
+
+	err := client.Query(ctx, &query, variables)
+	if isGraphQLNotFound(err) || (err == nil && query.Node.ProjectV2Item.ID == nil) {
+		log.Printf("[INFO] Removing project item %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	item := query.Node.ProjectV2Item
+	if err := d.Set("project_id", item.Project.ID.(string)); err != nil {
+		return diag.FromErr(err)
+	}
+	if item.Content.Node.ID != nil {
+		if err := d.Set("content_id", item.Content.Node.ID.(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("content_type", string(item.Type)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("archived", bool(item.IsArchived)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	remoteValues := make(map[string]map[string]any)
+	for _, v := range item.FieldValues.Nodes {
+		if fieldID, value := v.flatten(); fieldID != "" {
+			remoteValues[fieldID] = value
+		}
+	}
+
+	// Values of fields which are not configured are left unmanaged.
+	fieldValues := make([]any, 0)
+	for _, v := range d.Get("field_value").(*schema.Set).List() {
+		fieldID := v.(map[string]any)["field_id"].(string)
+		if value, ok := remoteValues[fieldID]; ok {
+			fieldValues = append(fieldValues, value)
+		}
+	}
+	if err := d.Set("field_value", fieldValues); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubProjectV2ItemUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	if err := updateProjectV2Item(ctx, d, m.(*Owner)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubProjectV2ItemRead(ctx, d, m)
+}
+
+func resourceGithubProjectV2ItemDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var mutation struct {
+		DeleteProjectV2Item struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"deleteProjectV2Item(input:$input)"`
+	}
+	input := githubv4.DeleteProjectV2ItemInput{
+		ProjectID: githubv4.ID(d.Get("project_id").(string)),
+		ItemID:    githubv4.ID(d.Id()),
+	}
+
+	err := client.Mutate(ctx, &mutation, input, nil)
+	if isGraphQLNotFound(err) {
+		return nil
+	}
+	return diag.FromErr(err)
+}
+
+// updateProjectV2Item applies changes to the archived state and field values of the item.
+func updateProjectV2Item(ctx context.Context, d *schema.ResourceData, meta *Owner) error {
+	client := meta.v4client
+	projectID := githubv4.ID(d.Get("project_id").(string))
+	itemID := githubv4.ID(d.Id())
+
+	if d.HasChange("archived") {
+		if d.Get("archived").(bool) {
+			var mutation struct {
+				ArchiveProjectV2Item struct {
+					ClientMutationID githubv4.String `graphql:"clientMutationId"`
+				} `graphql:"archiveProjectV2Item(input:$input)"`
+			}
+			input := githubv4.ArchiveProjectV2ItemInput{ProjectID: projectID, ItemID: itemID}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return err
+			}
+		} else {
+			var mutation struct {
+				UnarchiveProjectV2Item struct {
+					ClientMutationID githubv4.String `graphql:"clientMutationId"`
+				} `graphql:"unarchiveProjectV2Item(input:$input)"`
+			}
+			input := githubv4.UnarchiveProjectV2ItemInput{ProjectID: projectID, ItemID: itemID}
+			if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !d.HasChange("field_value") {
+		return nil
+	}
+
+	o, n := d.GetChange("field_value")
+	oldValues := make(map[string]map[string]any)
+	for _, v := range o.(*schema.Set).List() {
+		value := v.(map[string]any)
+		oldValues[value["field_id"].(string)] = value
+	}
+
+	newValues := make(map[string]bool)
+	for _, v := range n.(*schema.Set).List() {
+		value := v.(map[string]any)
+		fieldID := value["field_id"].(string)
+		newValues[fieldID] = true
+
+		fieldValue, err := expandProjectV2FieldValue(value)
+		if err != nil {
+			return err
+		}
+
+		var mutation struct {
+			UpdateProjectV2ItemFieldValue struct {
+				ClientMutationID githubv4.String `graphql:"clientMutationId"`
+			} `graphql:"updateProjectV2ItemFieldValue(input:$input)"`
+		}
+		input := githubv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: projectID,
+			ItemID:    itemID,
+			FieldID:   githubv4.ID(fieldID),
+			Value:     fieldValue,
+		}
+		if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+			return fmt.Errorf("error updating value of field %s: %w", fieldID, err)
+		}
+	}
+
+	for fieldID := range oldValues {
+		if newValues[fieldID] {
+			continue
+		}
+
+		var mutation struct {
+			ClearProjectV2ItemFieldValue struct {
+				ClientMutationID githubv4.String `graphql:"clientMutationId"`
+			} `graphql:"clearProjectV2ItemFieldValue(input:$input)"`
+		}
+		input := githubv4.ClearProjectV2ItemFieldValueInput{
+			ProjectID: projectID,
+			ItemID:    itemID,
+			FieldID:   githubv4.ID(fieldID),
+		}
+		if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+			return fmt.Errorf("error clearing value of field %s: %w", fieldID, err)
+		}
+	}
+
+	return nil
+}
+
+// projectV2ItemFieldValueFragment holds the attributes of the supported ProjectV2ItemFieldValue union members.
+type projectV2ItemFieldValueFragment struct {
+	Typename githubv4.String `graphql:"__typename"`
+	Common   struct {
+		Field struct {
+			Common struct {
+				ID githubv4.ID
+			} `graphql:"... on ProjectV2FieldCommon"`
+		}
+	} `graphql:"... on ProjectV2ItemFieldValueCommon"`
+	TextValue struct {
+		Text githubv4.String
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	NumberValue struct {
+		Number githubv4.Float
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	DateValue struct {
+		Date githubv4.String
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+	SingleSelectValue struct {
+		OptionID githubv4.String `graphql:"optionId"`
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	IterationValue struct {
+		IterationID githubv4.String `graphql:"iterationId"`
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+}
+
+// flatten returns the field ID and the field_value representation of the value, or an empty field ID for
+// value types which cannot be managed.
+func (v projectV2ItemFieldValueFragment) flatten() (string, map[string]any) {
+	if v.Common.Field.Common.ID == nil {
+		return "", nil
+	}
+	fieldID := v.Common.Field.Common.ID.(string)
+
+	value := map[string]any{
+		"field_id":                fieldID,
+		"text":                    "",
+		"number":                  0.0,
+		"date":                    "",
+		"single_select_option_id": "",
+		"iteration_id":            "",
+	}
+
+	switch v.Typename {
+	case "ProjectV2ItemFieldTextValue":
+		value["text"] = string(v.TextValue.Text)
+	case "ProjectV2ItemFieldNumberValue":
+		value["number"] = float64(v.NumberValue.Number)
+	case "ProjectV2ItemFieldDateValue":
+		value["date"] = string(v.DateValue.Date)
+	case "ProjectV2ItemFieldSingleSelectValue":
+		value["single_select_option_id"] = string(v.SingleSelectValue.OptionID)
+	case "ProjectV2ItemFieldIterationValue":
+		value["iteration_id"] = string(v.IterationValue.IterationID)
+	default:
+		return "", nil
+	}
+
+	return fieldID, value
+}
+
+// expandProjectV2FieldValue converts a field_value into the input for the updateProjectV2ItemFieldValue
+// mutation. As only one value can be set, the first non-empty one is used, falling back to number.
+func expandProjectV2FieldValue(value map[string]any) (githubv4.ProjectV2FieldValue, error) {
+	if text := value["text"].(string); text != "" {
+		return githubv4.ProjectV2FieldValue{Text: new(githubv4.String(text))}, nil
+	}
+	if date := value["date"].(string); date != "" {
+		t, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid date %q: %w", date, err)
+		}
+		return githubv4.ProjectV2FieldValue{Date: &githubv4.Date{Time: t}}, nil
+	}
+	if optionID := value["single_select_option_id"].(string); optionID != "" {
+		return githubv4.ProjectV2FieldValue{SingleSelectOptionID: new(githubv4.String(optionID))}, nil
+	}
+	if iterationID := value["iteration_id"].(string); iterationID != "" {
+		return githubv4.ProjectV2FieldValue{IterationID: new(githubv4.String(iterationID))}, nil
+	}
+
+	return githubv4.ProjectV2FieldValue{Number: new(githubv4.Float(value["number"].(float64)))}, nil
+}
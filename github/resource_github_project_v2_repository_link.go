@@ -0,0 +1,164 @@
+package github
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2RepositoryLink() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The node ID of the project.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository to link to the project.",
+			},
+			"repository_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The node ID of the repository.",
+			},
+		},
+
+		CreateContext: resourceGithubProjectV2RepositoryLinkCreate,
+		ReadContext:   resourceGithubProjectV2RepositoryLinkRead,
+		DeleteContext: resourceGithubProjectV2RepositoryLinkDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubProjectV2RepositoryLinkImport,
+		},
+	}
+}
+
+func resourceGithubProjectV2RepositoryLinkCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	projectID := d.Get("project_id").(string)
+	repoName := d.Get("repository").(string)
+
+	repoID, err := getRepositoryID(repoName, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var mutation struct {
+		LinkProjectV2ToRepository struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"linkProjectV2ToRepository(input:$input)"`
+	}
+	input := githubv4.LinkProjectV2ToRepositoryInput{
+		ProjectID:    githubv4.ID(projectID),
+		RepositoryID: repoID,
+	}
+
+	if err := client.Mutate(ctx, &mutation, input, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := buildID(projectID, repoName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return resourceGithubProjectV2RepositoryLinkRead(ctx, d, m)
+}
+
+func resourceGithubProjectV2RepositoryLinkRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	projectID := d.Get("project_id").(string)
+	repoName := d.Get("repository").(string)
+
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				Repositories struct {
+					Nodes []struct {
+						ID   githubv4.ID
+						Name githubv4.String
+					}
+					PageInfo PageInfo
+				} `graphql:"repositories(first:100, after:$cursor)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]any{
+		"id":     githubv4.ID(projectID),
+		"cursor": (*githubv4.String)(nil),
+	}
+
+	for {
+		err := client.Query(ctx, &query, variables)
+		if isGraphQLNotFound(err) {
+			break
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for _, repo := range query.Node.ProjectV2.Repositories.Nodes {
+			if string(repo.Name) == repoName {
+				if err := d.Set("repository_id", repo.ID.(string)); err != nil {
+					return diag.FromErr(err)
+				}
+				return nil
+			}
+		}
+
+		if !query.Node.ProjectV2.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = new(query.Node.ProjectV2.Repositories.PageInfo.EndCursor)
+	}
+
+	log.Printf("[INFO] Removing project repository link %s from state because it no longer exists in GitHub", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func resourceGithubProjectV2RepositoryLinkDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	var mutation struct {
+		UnlinkProjectV2FromRepository struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"unlinkProjectV2FromRepository(input:$input)"`
+	}
+	input := githubv4.UnlinkProjectV2FromRepositoryInput{
+		ProjectID:    githubv4.ID(d.Get("project_id").(string)),
+		RepositoryID: githubv4.ID(d.Get("repository_id").(string)),
+	}
+
+	err := client.Mutate(ctx, &mutation, input, nil)
+	if isGraphQLNotFound(err) {
+		return nil
+	}
+	return diag.FromErr(err)
+}
+
+func resourceGithubProjectV2RepositoryLinkImport(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+	projectID, repoName, err := parseID2(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("project_id", projectID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("repository", repoName); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
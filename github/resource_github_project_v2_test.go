@@ -0,0 +1,118 @@
+package github
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubProjectV2(t *testing.T) {
+	t.Run("creates and updates a project with fields", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_project_v2" "test" {
+				title             = "%[1]sproject-%[2]s"
+				short_description = "%[3]s"
+			}
+
+			resource "github_project_v2_field" "status" {
+				project_id = github_project_v2.test.id
+				name       = "Stage"
+				data_type  = "SINGLE_SELECT"
+
+				single_select_option {
+					name  = "Todo"
+					color = "GRAY"
+				}
+
+				single_select_option {
+					name  = "Done"
+					color = "GREEN"
+				}
+			}
+
+			resource "github_project_v2_field" "estimate" {
+				project_id = github_project_v2.test.id
+				name       = "Estimate"
+				data_type  = "NUMBER"
+			}
+
+			data "github_project_v2" "test" {
+				number = github_project_v2.test.number
+
+				depends_on = [
+					github_project_v2_field.status,
+					github_project_v2_field.estimate,
+				]
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "created by terraform"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_project_v2.test", "short_description", "created by terraform"),
+						resource.TestCheckResourceAttrSet("github_project_v2.test", "number"),
+						resource.TestCheckResourceAttrSet("github_project_v2.test", "url"),
+						resource.TestCheckResourceAttr("github_project_v2_field.status", "single_select_option.#", "2"),
+						resource.TestCheckResourceAttrSet("github_project_v2_field.status", "single_select_option.0.id"),
+						resource.TestCheckResourceAttrPair("data.github_project_v2.test", "id", "github_project_v2.test", "id"),
+						resource.TestCheckResourceAttrPair("data.github_project_v2.test", "title", "github_project_v2.test", "title"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "updated by terraform"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_project_v2.test", "short_description", "updated by terraform"),
+					),
+				},
+				{
+					ResourceName:      "github_project_v2_field.status",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+
+	t.Run("links a repository to a project", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name = "%[1]srepo-project-%[2]s"
+			}
+
+			resource "github_project_v2" "test" {
+				title = "%[1]sproject-%[2]s"
+			}
+
+			resource "github_project_v2_repository_link" "test" {
+				project_id = github_project_v2.test.id
+				repository = github_repository.test.name
+			}
+		`, testResourcePrefix, randomID)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnlessHasOrgs(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttrPair("github_project_v2_repository_link.test", "repository_id", "github_repository.test", "node_id"),
+					),
+				},
+				{
+					ResourceName:      "github_project_v2_repository_link.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,196 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubProjectV2View() *schema.Resource {
+	return &schema.Resource{
+		// GitHub only supports creating views, so every argument forces a new view.
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The node ID of the project.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the view.",
+			},
+			"layout": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"table", "board", "roadmap"}, false)),
+				Description:      "The layout of the view. Must be one of 'table', 'board' or 'roadmap'.",
+			},
+			"filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The filter query of the view, e.g. 'is:issue is:open'.",
+			},
+			"visible_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The database IDs of the fields shown in the view.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the view.",
+			},
+		},
+
+		CreateContext: resourceGithubProjectV2ViewCreate,
+		ReadContext:   resourceGithubProjectV2ViewRead,
+		DeleteContext: resourceGithubProjectV2ViewDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceGithubProjectV2ViewCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	meta := m.(*Owner)
+	client := meta.v3client
+
+	projectID := d.Get("project_id").(string)
+	projectNumber, err := getProjectV2Number(ctx, meta, projectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ownerPath := "users"
+	if meta.IsOrganization {
+		ownerPath = "orgs"
+	}
+
+	payload := map[string]any{
+		"name":   d.Get("name").(string),
+		"layout": d.Get("layout").(string),
+	}
+	if filter, ok := d.GetOk("filter"); ok {
+		payload["filter"] = filter.(string)
+	}
+	if visibleFields, ok := d.GetOk("visible_fields"); ok {
+		payload["visible_fields"] = visibleFields.([]any)
+	}
+
+	req, err := client.NewRequest("POST", fmt.Sprintf("%s/%s/projectsV2/%d/views", ownerPath, meta.name, projectNumber), payload)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var view struct {
+		Number int `json:"number"`
+	}
+	if _, err := client.Do(ctx, req, &view); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := buildID(projectID, strconv.Itoa(view.Number))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return resourceGithubProjectV2ViewRead(ctx, d, m)
+}
+
+func resourceGithubProjectV2ViewRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := m.(*Owner).v4client
+
+	projectID, numberPart, err := parseID2(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	number, err := strconv.Atoi(numberPart)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid view number %q: %w", numberPart, err))
+	}
+
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				View *struct {
+					Name   githubv4.String
+					Layout githubv4.ProjectV2ViewLayout
+					Filter githubv4.String
+					Number githubv4.Int
+					// Projects have at most 50 fields.
+					VisibleFields struct {
+						Nodes []projectV2FieldFragment
+					} `graphql:"visibleFields(first:100)"`
+				} `graphql:"view(number:$number)"`
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]any{
+		"id":     githubv4.ID(projectID),
+		"number": githubv4.Int(number),
+	}
+
+	err = client.Query(ctx, &query, variables)
+	if isGraphQLNotFound(err) || (err == nil && query.Node.ProjectV2.View == nil) {
+		log.Printf("[INFO] Removing project view %s from state because it no longer exists in GitHub", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	view := query.Node.ProjectV2.View
+	if err := d.Set("project_id", projectID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", string(view.Name)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("layout", strings.ToLower(strings.TrimSuffix(string(view.Layout), "_LAYOUT"))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("filter", string(view.Filter)); err != nil {
+		return diag.FromErr(err)
+	}
+	visibleFields := make([]int, 0, len(view.VisibleFields.Nodes))
+	for _, f := range view.VisibleFields.Nodes {
+		visibleFields = append(visibleFields, f.flatten().DatabaseID)
+	}
+	if err := d.Set("visible_fields", visibleFields); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("number", int(view.Number)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubProjectV2ViewDelete(_ context.Context, d *schema.ResourceData, _ any) diag.Diagnostics {
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Project view was not deleted",
+			Detail:   fmt.Sprintf("The GitHub API does not support deleting project views. View %s has been removed from the Terraform state but must be deleted in the GitHub UI.", d.Id()),
+		},
+	}
+}
@@ -0,0 +1,416 @@
+package github
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubReleaseAsset() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGithubReleaseAssetCreate,
+		ReadContext:   resourceGithubReleaseAssetRead,
+		UpdateContext: resourceGithubReleaseAssetUpdate,
+		DeleteContext: resourceGithubReleaseAssetDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubReleaseAssetImport,
+		},
+
+		CustomizeDiff: resourceGithubReleaseAssetDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"release_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the release to upload the asset to.",
+			},
+			"file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"file", "content"},
+				Description:  "The path of a local file to upload.",
+			},
+			"content": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"file", "content"},
+				RequiredWith: []string{"name"},
+				Description:  "The content to upload, as an alternative to 'file'.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The file name of the asset. Defaults to the base name of 'file'.",
+				DiffSuppressFunc: func(_, o, n string, _ *schema.ResourceData) bool {
+					return o != "" && releaseAssetName(o) == releaseAssetName(n)
+				},
+			},
+			"label": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A short description of the asset, shown instead of the file name.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The MIME type of the asset. Defaults to a type derived from the file name extension.",
+			},
+			"overwrite": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to replace an existing asset with the same name when creating the asset.",
+			},
+			"content_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA-256 hash of the uploaded content. A change of the content replaces the asset.",
+			},
+			"asset_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the asset.",
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The node ID of the asset.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the asset in bytes.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API URL of the asset.",
+			},
+			"browser_download_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The browser URL from which the asset can be downloaded.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the asset was created.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date the asset was updated.",
+			},
+		},
+	}
+}
+
+// resourceGithubReleaseAssetDiff hashes the configured content so that a changed file replaces the asset, and
+// defaults the name to the base name of the file as GitHub renames it.
+func resourceGithubReleaseAssetDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if !d.NewValueKnown("file") || !d.NewValueKnown("content") {
+		if err := d.SetNewComputed("content_sha256"); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			return d.ForceNew("content_sha256")
+		}
+		return nil
+	}
+
+	// The name of an existing asset is compared as renamed by GitHub, so that a file whose name GitHub changes
+	// isn't replaced on every plan.
+	file := d.Get("file").(string)
+	if name := releaseAssetName(filepath.Base(file)); file != "" && d.GetRawConfig().GetAttr("name").IsNull() && releaseAssetName(d.Get("name").(string)) != name {
+		if err := d.SetNew("name", name); err != nil {
+			return err
+		}
+	}
+
+	content, err := releaseAssetContent(file, d.Get("content").(string))
+	if err != nil {
+		return err
+	}
+
+	hash := releaseAssetHash(content)
+	if d.Get("content_sha256").(string) == hash {
+		return nil
+	}
+
+	if err := d.SetNew("content_sha256", hash); err != nil {
+		return err
+	}
+
+	// Assets without a stored hash, e.g. imported ones, adopt the hash of the configured content.
+	if o, _ := d.GetChange("content_sha256"); d.Id() != "" && o.(string) != "" {
+		return d.ForceNew("content_sha256")
+	}
+
+	return nil
+}
+
+func resourceGithubReleaseAssetCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName := d.Get("repository").(string)
+	releaseID := int64(d.Get("release_id").(int))
+
+	content, err := releaseAssetContent(d.Get("file").(string), d.Get("content").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+	if name == "" {
+		name = releaseAssetName(filepath.Base(d.Get("file").(string)))
+	}
+
+	release, _, err := client.Repositories.GetRelease(ctx, owner, repoName, releaseID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	existing, err := findReleaseAssetByName(ctx, client, owner, repoName, releaseID, name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if existing != nil {
+		if !d.Get("overwrite").(bool) {
+			return diag.Errorf("release %d of %s/%s already has an asset named %q; set overwrite to replace it or import it", releaseID, owner, repoName, name)
+		}
+
+		log.Printf("[INFO] Deleting existing release asset %s (%d) before uploading its replacement", name, existing.GetID())
+		if _, err := client.Repositories.DeleteReleaseAsset(ctx, owner, repoName, existing.GetID()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	opts := &github.UploadOptions{
+		Name:      name,
+		Label:     d.Get("label").(string),
+		MediaType: d.Get("content_type").(string),
+	}
+	asset, _, err := client.Repositories.UploadReleaseAssetFromRelease(ctx, release, opts, bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := buildID(repoName, strconv.FormatInt(asset.GetID(), 10))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	if err := d.Set("content_sha256", releaseAssetHash(content)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubReleaseAssetRead(ctx, d, meta)
+}
+
+func resourceGithubReleaseAssetRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoName, assetIDPart, err := parseID2(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	assetID, err := strconv.ParseInt(assetIDPart, 10, 64)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("invalid asset ID %q: %w", assetIDPart, err))
+	}
+
+	asset, _, err := client.Repositories.GetReleaseAsset(ctx, owner, repoName, assetID)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing release asset %s from state because it no longer exists in GitHub", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("repository", repoName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("asset_id", asset.GetID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", asset.GetName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("label", asset.GetLabel()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("content_type", asset.GetContentType()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("node_id", asset.GetNodeID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("size", asset.GetSize()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("url", asset.GetURL()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("browser_download_url", asset.GetBrowserDownloadURL()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", asset.GetCreatedAt().String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("updated_at", asset.GetUpdatedAt().String()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubReleaseAssetUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	if d.HasChange("label") {
+		asset := &github.ReleaseAsset{
+			Name:  new(d.Get("name").(string)),
+			Label: new(d.Get("label").(string)),
+		}
+		if _, _, err := client.Repositories.EditReleaseAsset(ctx, owner, d.Get("repository").(string), int64(d.Get("asset_id").(int)), asset); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGithubReleaseAssetRead(ctx, d, meta)
+}
+
+func resourceGithubReleaseAssetDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	_, err := client.Repositories.DeleteReleaseAsset(ctx, owner, d.Get("repository").(string), int64(d.Get("asset_id").(int)))
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubReleaseAssetImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	// <repository>:<release_id>:<asset_id>
+	repoName, releaseIDPart, assetIDPart, err := parseID3(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID specified: supplied ID must be written as <repository>:<release_id>:<asset_id> (%q): %w", d.Id(), err)
+	}
+
+	releaseID, err := strconv.Atoi(releaseIDPart)
+	if err != nil {
+		return nil, fmt.Errorf("invalid release ID %q: %w", releaseIDPart, err)
+	}
+
+	id, err := buildID(repoName, assetIDPart)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+
+	if err := d.Set("release_id", releaseID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("overwrite", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// releaseAssetContent returns the content to upload, read from file if it is set.
+func releaseAssetContent(file, content string) ([]byte, error) {
+	if file == "" {
+		return []byte(content), nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading release asset file: %w", err)
+	}
+	return data, nil
+}
+
+// releaseAssetName returns the name GitHub gives an asset uploaded with the given name. GitHub replaces runs of
+// characters other than letters, digits, '-', '_' and '+' with a single '.', and removes leading and trailing periods.
+// https://docs.github.com/en/rest/releases/assets#upload-a-release-asset
+func releaseAssetName(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', strings.ContainsRune("-_+", r):
+			b.WriteRune(r)
+		case !strings.HasSuffix(b.String(), "."):
+			b.WriteRune('.')
+		}
+	}
+	return strings.Trim(b.String(), ".")
+}
+
+func releaseAssetHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// findReleaseAssetByName returns the asset of the release which has the given name once renamed by GitHub.
+func findReleaseAssetByName(ctx context.Context, client *github.Client, owner, repoName string, releaseID int64, name string) (*github.ReleaseAsset, error) {
+	name = releaseAssetName(name)
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		assets, resp, err := client.Repositories.ListReleaseAssets(ctx, owner, repoName, releaseID, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, asset := range assets {
+			if releaseAssetName(asset.GetName()) == name {
+				return asset, nil
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
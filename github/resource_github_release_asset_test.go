@@ -0,0 +1,300 @@
+package github
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func newReleaseAssetTestOwner(t *testing.T, mux *http.ServeMux) *Owner {
+	t.Helper()
+
+	meta := newTestOwner(t, mux, "test-owner", false)
+	mux.HandleFunc("GET /repos/test-owner/repo/releases/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, fmt.Sprintf(`{"id": 1, "upload_url": "%suploads/repos/test-owner/repo/releases/1/assets{?name,label}"}`, meta.v3client.BaseURL))
+	})
+	mux.HandleFunc("GET /repos/test-owner/repo/releases/assets/7", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"id": 7, "name": "app.zip", "label": "App", "content_type": "application/zip", "size": 5}`)
+	})
+
+	return meta
+}
+
+func TestGithubReleaseAssetCreate(t *testing.T) {
+	t.Run("uploads the configured content", func(t *testing.T) {
+		var uploaded string
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/test-owner/repo/releases/1/assets", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `[]`)
+		})
+		mux.HandleFunc("POST /uploads/repos/test-owner/repo/releases/1/assets", func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("name"); got != "app.zip" {
+				t.Errorf("got upload name %q; want %q", got, "app.zip")
+			}
+			body, _ := io.ReadAll(r.Body)
+			uploaded = string(body)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, `{"id": 7}`)
+		})
+		meta := newReleaseAssetTestOwner(t, mux)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubReleaseAsset().Schema, map[string]any{
+			"repository": "repo",
+			"release_id": 1,
+			"content":    "hello",
+			"name":       "app.zip",
+		})
+
+		if diags := resourceGithubReleaseAssetCreate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if uploaded != "hello" {
+			t.Errorf("got uploaded content %q; want %q", uploaded, "hello")
+		}
+		if d.Id() != "repo:7" {
+			t.Errorf("got ID %q; want %q", d.Id(), "repo:7")
+		}
+		if got := d.Get("content_sha256").(string); got != releaseAssetHash([]byte("hello")) {
+			t.Errorf("got content_sha256 %q", got)
+		}
+		if got := d.Get("label").(string); got != "App" {
+			t.Errorf("got label %q; want %q", got, "App")
+		}
+	})
+
+	t.Run("fails on an existing asset with the same name", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/test-owner/repo/releases/1/assets", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `[{"id": 5, "name": "app.zip"}]`)
+		})
+		meta := newReleaseAssetTestOwner(t, mux)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubReleaseAsset().Schema, map[string]any{
+			"repository": "repo",
+			"release_id": 1,
+			"content":    "hello",
+			"name":       "app.zip",
+		})
+
+		diags := resourceGithubReleaseAssetCreate(t.Context(), d, meta)
+		if !diags.HasError() {
+			t.Fatal("expected an error for the existing asset")
+		}
+		if !strings.Contains(diags[0].Summary, "already has an asset named") {
+			t.Errorf("unexpected error: %s", diags[0].Summary)
+		}
+	})
+
+	t.Run("replaces an existing asset with overwrite", func(t *testing.T) {
+		deleted := false
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/test-owner/repo/releases/1/assets", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `[{"id": 5, "name": "app.zip"}]`)
+		})
+		mux.HandleFunc("DELETE /repos/test-owner/repo/releases/assets/5", func(w http.ResponseWriter, _ *http.Request) {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("POST /uploads/repos/test-owner/repo/releases/1/assets", func(w http.ResponseWriter, _ *http.Request) {
+			if !deleted {
+				t.Error("expected the existing asset to be deleted before the upload")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, `{"id": 7}`)
+		})
+		meta := newReleaseAssetTestOwner(t, mux)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubReleaseAsset().Schema, map[string]any{
+			"repository": "repo",
+			"release_id": 1,
+			"content":    "hello",
+			"name":       "app.zip",
+			"overwrite":  true,
+		})
+
+		if diags := resourceGithubReleaseAssetCreate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if !deleted {
+			t.Error("expected the existing asset to be deleted")
+		}
+	})
+
+	t.Run("replaces an existing asset with the name as renamed by GitHub", func(t *testing.T) {
+		deleted := false
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/test-owner/repo/releases/1/assets", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `[{"id": 5, "name": "my.app.zip"}]`)
+		})
+		mux.HandleFunc("DELETE /repos/test-owner/repo/releases/assets/5", func(w http.ResponseWriter, _ *http.Request) {
+			deleted = true
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("POST /uploads/repos/test-owner/repo/releases/1/assets", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, `{"id": 7}`)
+		})
+		meta := newReleaseAssetTestOwner(t, mux)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubReleaseAsset().Schema, map[string]any{
+			"repository": "repo",
+			"release_id": 1,
+			"content":    "hello",
+			"name":       "my app.zip",
+			"overwrite":  true,
+		})
+
+		if diags := resourceGithubReleaseAssetCreate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if !deleted {
+			t.Error("expected the existing asset to be deleted")
+		}
+	})
+}
+
+func TestGithubReleaseAssetDiff(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "my app (linux).zip")
+	if err := os.WriteFile(file, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name     string
+		state    string
+		config   map[string]any
+		wantName string
+	}{
+		{name: "keeps the name of the asset as renamed by GitHub", state: "my.app.linux.zip", config: map[string]any{}},
+		{name: "keeps a configured name as renamed by GitHub", state: "my.app.zip", config: map[string]any{"name": "my app.zip"}},
+		{name: "renames the asset to the new file name", state: "other.zip", config: map[string]any{}, wantName: "my.app.linux.zip"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "repo:7",
+				Attributes: map[string]string{
+					"id":             "repo:7",
+					"repository":     "repo",
+					"release_id":     "1",
+					"file":           file,
+					"name":           tc.state,
+					"content_type":   "application/zip",
+					"overwrite":      "false",
+					"content_sha256": releaseAssetHash([]byte("hello")),
+				},
+			}
+			config := map[string]any{"repository": "repo", "release_id": 1, "file": file}
+			maps.Copy(config, tc.config)
+
+			// The diff reads whether the name is configured from the raw config.
+			r := resourceGithubReleaseAsset()
+			rawConfig := make(map[string]cty.Value)
+			for k, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+				rawConfig[k] = cty.NullVal(ty)
+			}
+			if name, ok := config["name"].(string); ok {
+				rawConfig["name"] = cty.StringVal(name)
+			}
+			state.RawConfig = cty.ObjectVal(rawConfig)
+
+			diff, err := r.Diff(t.Context(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.wantName == "" {
+				if diff != nil && diff.Attributes["name"] != nil {
+					t.Errorf("got name diff %+v; want none", diff.Attributes["name"])
+				}
+				return
+			}
+			if diff == nil {
+				t.Fatalf("got no diff; want the asset replaced with name %q", tc.wantName)
+			}
+			if diff.Attributes["name"] == nil || diff.Attributes["name"].New != tc.wantName || !diff.RequiresNew() {
+				t.Errorf("got name diff %+v; want the asset replaced with name %q", diff.Attributes["name"], tc.wantName)
+			}
+		})
+	}
+}
+
+func TestAccGithubReleaseAsset(t *testing.T) {
+	t.Run("uploads and replaces a release asset", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		file := filepath.Join(t.TempDir(), "notes.txt")
+
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "%[1]srepo-release-asset-%[2]s"
+				auto_init = true
+			}
+
+			resource "github_release" "test" {
+				repository = github_repository.test.name
+				tag_name   = "v1.0.0"
+			}
+
+			resource "github_release_asset" "test" {
+				repository = github_repository.test.name
+				release_id = github_release.test.release_id
+				file       = "%[3]s"
+				label      = "Release notes"
+			}
+		`, testResourcePrefix, randomID, filepath.ToSlash(file))
+
+		writeFile := func(content string) func() {
+			return func() {
+				if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		writeFile("first")()
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_release_asset.test", "name", "notes.txt"),
+						resource.TestCheckResourceAttr("github_release_asset.test", "label", "Release notes"),
+						resource.TestCheckResourceAttr("github_release_asset.test", "size", "5"),
+						resource.TestCheckResourceAttr("github_release_asset.test", "content_sha256", releaseAssetHash([]byte("first"))),
+						resource.TestCheckResourceAttrSet("github_release_asset.test", "browser_download_url"),
+					),
+				},
+				{
+					PreConfig: writeFile("second!"),
+					Config:    config,
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_release_asset.test", "size", "7"),
+						resource.TestCheckResourceAttr("github_release_asset.test", "content_sha256", releaseAssetHash([]byte("second!"))),
+					),
+				},
+			},
+		})
+	})
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
 )
 
 func resourceGithubRepository() *schema.Resource {
@@ -800,24 +801,44 @@ func resourceGithubRepositoryRead(ctx context.Context, d *schema.ResourceData, m
 		ctx = context.WithValue(ctx, ctxEtag, d.Get("etag").(string))
 	}
 
-	repo, resp, err := client.Repositories.Get(ctx, owner, repoName)
-	if err != nil {
-		var ghErr *github.ErrorResponse
-		if errors.As(err, &ghErr) {
-			if ghErr.Response.StatusCode == http.StatusNotModified {
-				return nil
-			}
-			if ghErr.Response.StatusCode == http.StatusNotFound {
-				log.Printf("[INFO] Removing repository %s/%s from state because it no longer exists in GitHub",
-					owner, repoName)
-				d.SetId("")
-				return nil
+	// When batching is enabled, existing repositories are read along with concurrent reads in a single GraphQL
+	// query. Repositories the batch couldn't read, or which use settings GraphQL doesn't serve, fall back to the
+	// REST API.
+	var batched *batchedRepository
+	if batcher := meta.(*Owner).repositoryBatcher; batcher != nil && owner != "" && !d.IsNewResource() && !readsRESTOnlyRepositorySettings(d) {
+		batched = batcher.get(ctx, owner, repoName)
+	}
+
+	var repo *github.Repository
+	if batched != nil {
+		repo = batched.toRepository()
+		// The GraphQL API doesn't serve the default branch of empty repositories.
+		if repo.DefaultBranch == nil {
+			repo.DefaultBranch = new(d.Get("default_branch").(string))
+		}
+	} else {
+		var resp *github.Response
+		var err error
+		repo, resp, err = client.Repositories.Get(ctx, owner, repoName)
+		if err != nil {
+			var ghErr *github.ErrorResponse
+			if errors.As(err, &ghErr) {
+				if ghErr.Response.StatusCode == http.StatusNotModified {
+					return nil
+				}
+				if ghErr.Response.StatusCode == http.StatusNotFound {
+					log.Printf("[INFO] Removing repository %s/%s from state because it no longer exists in GitHub",
+						owner, repoName)
+					d.SetId("")
+					return nil
+				}
 			}
+			return diag.FromErr(err)
 		}
-		return diag.FromErr(err)
+
+		_ = d.Set("etag", resp.Header.Get("ETag"))
 	}
 
-	_ = d.Set("etag", resp.Header.Get("ETag"))
 	_ = d.Set("name", repoName)
 	_ = d.Set("description", repo.GetDescription())
 	_ = d.Set("primary_language", repo.GetLanguage())
@@ -852,7 +873,10 @@ func resourceGithubRepositoryRead(ctx context.Context, d *schema.ResourceData, m
 		_ = d.Set("allow_forking", repo.GetAllowForking())
 		_ = d.Set("delete_branch_on_merge", repo.GetDeleteBranchOnMerge())
 		_ = d.Set("web_commit_signoff_required", repo.GetWebCommitSignoffRequired())
-		_ = d.Set("has_downloads", repo.GetHasDownloads())
+		// The GraphQL API doesn't serve has_downloads, so batched reads leave it out of state.
+		if batched == nil {
+			_ = d.Set("has_downloads", repo.GetHasDownloads())
+		}
 		_ = d.Set("merge_commit_message", repo.GetMergeCommitMessage())
 		_ = d.Set("merge_commit_title", repo.GetMergeCommitTitle())
 		_ = d.Set("squash_merge_commit_message", repo.GetSquashMergeCommitMessage())
@@ -885,7 +909,7 @@ func resourceGithubRepositoryRead(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	if repo.TemplateRepository != nil {
-		if err = d.Set("template", []any{
+		if err := d.Set("template", []any{
 			map[string]any{
 				"owner":      repo.TemplateRepository.Owner.Login,
 				"repository": repo.TemplateRepository.Name,
@@ -894,11 +918,22 @@ func resourceGithubRepositoryRead(ctx context.Context, d *schema.ResourceData, m
 			return diag.FromErr(err)
 		}
 	} else {
-		if err = d.Set("template", []any{}); err != nil {
+		if err := d.Set("template", []any{}); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
+	// The GraphQL API doesn't serve GitHub Pages either, so batched reads leave pages out of state and a site enabled
+	// outside of Terraform is only detected by the REST API.
+	if batched != nil {
+		if batched.ViewerPermission == githubv4.RepositoryPermissionAdmin {
+			if err := d.Set("vulnerability_alerts", batched.HasVulnerabilityAlertsEnabled); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		return nil
+	}
+
 	if repo.GetSecurityAndAnalysis() != nil {
 		vulnerabilityAlerts, _, err := client.Repositories.GetVulnerabilityAlerts(ctx, owner, repoName)
 		if err != nil {
@@ -916,6 +951,13 @@ func resourceGithubRepositoryRead(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// readsRESTOnlyRepositorySettings reports whether the state of a repository tracks settings the GraphQL API doesn't
+// serve, which are security_and_analysis, has_downloads and GitHub Pages. Those repositories are read through the
+// REST API so the settings are refreshed.
+func readsRESTOnlyRepositorySettings(d *schema.ResourceData) bool {
+	return len(d.Get("security_and_analysis").([]any)) > 0 || d.Get("has_downloads").(bool) || len(d.Get("pages").([]any)) > 0
+}
+
 func resourceGithubRepositoryUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	// Can only update a repository if it is not archived or the update is to
 	// archive the repository (unarchiving is not supported by the GitHub API)
@@ -0,0 +1,311 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// codeScanningDefaultSetupPollInterval is how often the workflow run applying a default setup configuration is polled.
+var codeScanningDefaultSetupPollInterval = 10 * time.Second
+
+// codeScanningDefaultSetup represents a code scanning default setup configuration. It is a superset of
+// github.DefaultSetupConfiguration, which lacks the runner and threat model fields.
+type codeScanningDefaultSetup struct {
+	State       string     `json:"state,omitempty"`
+	Languages   []string   `json:"languages,omitempty"`
+	QuerySuite  string     `json:"query_suite,omitempty"`
+	RunnerType  string     `json:"runner_type,omitempty"`
+	RunnerLabel *string    `json:"runner_label,omitempty"`
+	ThreatModel string     `json:"threat_model,omitempty"`
+	Schedule    string     `json:"schedule,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
+
+func resourceGithubRepositoryCodeScanningDefaultSetup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the code scanning default setup of a repository.",
+
+		CreateContext: resourceGithubRepositoryCodeScanningDefaultSetupCreateOrUpdate,
+		ReadContext:   resourceGithubRepositoryCodeScanningDefaultSetupRead,
+		UpdateContext: resourceGithubRepositoryCodeScanningDefaultSetupCreateOrUpdate,
+		DeleteContext: resourceGithubRepositoryCodeScanningDefaultSetupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubRepositoryCodeScanningDefaultSetupImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Description: "The name of the repository.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"state": {
+				Description:      "Whether code scanning default setup is `configured` or `not-configured`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "configured",
+				ValidateDiagFunc: validateValueFunc([]string{"configured", "not-configured"}),
+			},
+			"query_suite": {
+				Description:      "The CodeQL query suite to run, either `default` or `extended`.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"default", "extended"}),
+			},
+			"languages": {
+				Description: "The languages to analyze. Defaults to the CodeQL supported languages detected in the repository.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateValueFunc([]string{"actions", "c-cpp", "csharp", "go", "java-kotlin", "javascript-typescript", "python", "ruby", "swift"}),
+				},
+			},
+			"runner_type": {
+				Description:      "Whether to run the analysis on `standard` GitHub-hosted runners or on `labeled` runners.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"standard", "labeled"}),
+			},
+			"runner_label": {
+				Description: "The label of the runners to run the analysis on when `runner_type` is `labeled`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"threat_model": {
+				Description:      "The threat model of the analysis, either `remote` or `remote_and_local`, which also treats local sources such as files and command line arguments as tainted.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validateValueFunc([]string{"remote", "remote_and_local"}),
+			},
+			"schedule": {
+				Description: "The frequency of the periodic analysis.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The time the configuration was last updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		CustomizeDiff: resourceGithubRepositoryCodeScanningDefaultSetupDiff,
+	}
+}
+
+func resourceGithubRepositoryCodeScanningDefaultSetupDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if label, ok := d.GetOk("runner_label"); ok && d.Get("runner_type").(string) != "labeled" {
+		return fmt.Errorf("runner_label %q can only be set when runner_type is %q", label, "labeled")
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryCodeScanningDefaultSetupCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	setup := codeScanningDefaultSetup{
+		State:       d.Get("state").(string),
+		QuerySuite:  d.Get("query_suite").(string),
+		RunnerType:  d.Get("runner_type").(string),
+		ThreatModel: d.Get("threat_model").(string),
+	}
+	if v, ok := d.GetOk("languages"); ok {
+		setup.Languages = expandStringList(v.(*schema.Set).List())
+	}
+	if setup.RunnerType == "labeled" {
+		setup.RunnerLabel = new(d.Get("runner_label").(string))
+	} else if setup.RunnerType == "standard" {
+		// The label of labeled runners is kept unless it is explicitly cleared.
+		setup.RunnerLabel = new("")
+	}
+
+	run, err := updateCodeScanningDefaultSetup(ctx, client, owner, repoName, setup)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(repoName)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	if run.GetRunID() != 0 {
+		if err := waitForCodeScanningDefaultSetupRun(ctx, client, owner, repoName, run, timeout); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGithubRepositoryCodeScanningDefaultSetupRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryCodeScanningDefaultSetupRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	req, err := client.NewRequest("GET", fmt.Sprintf("repos/%s/%s/code-scanning/default-setup", owner, repoName), nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var setup codeScanningDefaultSetup
+	_, err = client.Do(ctx, req, &setup)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) {
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				log.Printf("[INFO] Removing code scanning default setup %s/%s from state because it no longer exists in GitHub", owner, repoName)
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("repository", repoName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("state", setup.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("query_suite", setup.QuerySuite); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("languages", setup.Languages); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("runner_type", setup.RunnerType); err != nil {
+		return diag.FromErr(err)
+	}
+	var runnerLabel string
+	if setup.RunnerLabel != nil {
+		runnerLabel = *setup.RunnerLabel
+	}
+	if err = d.Set("runner_label", runnerLabel); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("threat_model", setup.ThreatModel); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("schedule", setup.Schedule); err != nil {
+		return diag.FromErr(err)
+	}
+	var updatedAt string
+	if setup.UpdatedAt != nil {
+		updatedAt = setup.UpdatedAt.Format(time.RFC3339)
+	}
+	if err = d.Set("updated_at", updatedAt); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryCodeScanningDefaultSetupDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Id()
+
+	// Disabling default setup doesn't start a workflow run, so there is nothing to wait for.
+	_, err := updateCodeScanningDefaultSetup(ctx, client, owner, repoName, codeScanningDefaultSetup{State: "not-configured"})
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+	}
+
+	return diag.FromErr(handleArchivedRepoDelete(err, "code scanning default setup", repoName, owner, repoName))
+}
+
+func resourceGithubRepositoryCodeScanningDefaultSetupImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := d.Set("repository", d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateCodeScanningDefaultSetup updates the default setup configuration of a repository, and returns the workflow
+// run applying it, if any.
+func updateCodeScanningDefaultSetup(ctx context.Context, client *github.Client, owner, repoName string, setup codeScanningDefaultSetup) (*github.UpdateDefaultSetupConfigurationResponse, error) {
+	req, err := client.NewRequest("PATCH", fmt.Sprintf("repos/%s/%s/code-scanning/default-setup", owner, repoName), setup)
+	if err != nil {
+		return nil, err
+	}
+
+	run := new(github.UpdateDefaultSetupConfigurationResponse)
+	_, err = client.Do(ctx, req, run)
+	if err != nil {
+		// The configuration is applied by a workflow run, which is reported as accepted while it is queued.
+		var acceptedErr *github.AcceptedError
+		if !errors.As(err, &acceptedErr) {
+			return nil, err
+		}
+		if len(acceptedErr.Raw) > 0 {
+			if err := json.Unmarshal(acceptedErr.Raw, run); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return run, nil
+}
+
+// waitForCodeScanningDefaultSetupRun waits for the workflow run applying a default setup configuration to complete,
+// and returns an error if it didn't succeed.
+func waitForCodeScanningDefaultSetupRun(ctx context.Context, client *github.Client, owner, repoName string, run *github.UpdateDefaultSetupConfigurationResponse, timeout time.Duration) error {
+	// Poll the run itself rather than the response cache, which would keep serving its queued state.
+	ctx = context.WithValue(ctx, ctxSkipCache, true)
+	conf := &retry.StateChangeConf{
+		Pending: []string{"requested", "queued", "pending", "waiting", "in_progress"},
+		Target:  []string{"completed"},
+		Refresh: func() (any, string, error) {
+			workflowRun, _, err := client.Actions.GetWorkflowRunByID(ctx, owner, repoName, run.GetRunID())
+			if err != nil {
+				return nil, "", err
+			}
+			return workflowRun, workflowRun.GetStatus(), nil
+		},
+		Timeout:      timeout,
+		Delay:        codeScanningDefaultSetupPollInterval,
+		PollInterval: codeScanningDefaultSetupPollInterval,
+	}
+
+	result, err := conf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for code scanning default setup of %s/%s to be applied by %s: %w", owner, repoName, run.GetRunURL(), err)
+	}
+
+	workflowRun := result.(*github.WorkflowRun)
+	if conclusion := workflowRun.GetConclusion(); conclusion != "success" {
+		return fmt.Errorf("code scanning default setup of %s/%s was not applied, the workflow run %s concluded with %q", owner, repoName, workflowRun.GetHTMLURL(), conclusion)
+	}
+
+	return nil
+}
@@ -0,0 +1,175 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubRepositoryCodeScanningDefaultSetup(t *testing.T) {
+	interval := codeScanningDefaultSetupPollInterval
+	codeScanningDefaultSetupPollInterval = time.Millisecond
+	t.Cleanup(func() { codeScanningDefaultSetupPollInterval = interval })
+
+	newMeta := func(t *testing.T, conclusion string) (*Owner, *codeScanningDefaultSetup) {
+		var patched codeScanningDefaultSetup
+		var polls atomic.Int32
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("PATCH /repos/test-owner/repo/code-scanning/default-setup", func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+				t.Error(err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusAccepted)
+			mustWrite(w, `{"run_id": 42, "run_url": "https://api.github.com/repos/test-owner/repo/actions/runs/42"}`)
+		})
+		mux.HandleFunc("GET /repos/test-owner/repo/actions/runs/42", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if polls.Add(1) < 3 {
+				mustWrite(w, `{"id": 42, "status": "in_progress"}`)
+				return
+			}
+			mustWrite(w, fmt.Sprintf(`{"id": 42, "status": "completed", "conclusion": %q, "html_url": "https://github.com/test-owner/repo/actions/runs/42"}`, conclusion))
+		})
+		mux.HandleFunc("GET /repos/test-owner/repo/code-scanning/default-setup", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `{
+				"state": "configured",
+				"languages": ["go", "python"],
+				"query_suite": "extended",
+				"runner_type": "labeled",
+				"runner_label": "codeql",
+				"threat_model": "remote_and_local",
+				"schedule": "weekly",
+				"updated_at": "2026-01-02T03:04:05Z"
+			}`)
+		})
+
+		return newTestOwner(t, mux, "test-owner", false), &patched
+	}
+	newData := func(t *testing.T) *schema.ResourceData {
+		return schema.TestResourceDataRaw(t, resourceGithubRepositoryCodeScanningDefaultSetup().Schema, map[string]any{
+			"repository":   "repo",
+			"query_suite":  "extended",
+			"runner_type":  "labeled",
+			"runner_label": "codeql",
+			"threat_model": "remote_and_local",
+		})
+	}
+
+	t.Run("waits for the configuration run to succeed", func(t *testing.T) {
+		meta, patched := newMeta(t, "success")
+		d := newData(t)
+
+		if diags := resourceGithubRepositoryCodeScanningDefaultSetupCreateOrUpdate(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if patched.State != "configured" || patched.RunnerType != "labeled" || patched.RunnerLabel == nil || *patched.RunnerLabel != "codeql" || patched.ThreatModel != "remote_and_local" {
+			t.Errorf("got configuration %+v", patched)
+		}
+		if d.Id() != "repo" {
+			t.Errorf("got ID %q; want %q", d.Id(), "repo")
+		}
+		if got := d.Get("languages").(*schema.Set).Len(); got != 2 {
+			t.Errorf("got %d languages; want 2", got)
+		}
+		if got := d.Get("updated_at").(string); got != "2026-01-02T03:04:05Z" {
+			t.Errorf("got updated_at %q", got)
+		}
+	})
+
+	t.Run("reports a failed configuration run", func(t *testing.T) {
+		meta, _ := newMeta(t, "failure")
+		d := newData(t)
+
+		diags := resourceGithubRepositoryCodeScanningDefaultSetupCreateOrUpdate(t.Context(), d, meta)
+		if !diags.HasError() {
+			t.Fatal("expected an error")
+		}
+		if !strings.Contains(diags[0].Summary, `concluded with "failure"`) {
+			t.Errorf("got error %q", diags[0].Summary)
+		}
+	})
+}
+
+func TestGithubRepositoryCodeScanningDefaultSetupDeleteArchived(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("PATCH /repos/test-owner/repo/code-scanning/default-setup", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		mustWrite(w, `{"message": "Repository was archived so is read-only."}`)
+	})
+
+	meta := newTestOwner(t, mux, "test-owner", false)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryCodeScanningDefaultSetup().Schema, map[string]any{
+		"repository": "repo",
+	})
+	d.SetId("repo")
+
+	if diags := resourceGithubRepositoryCodeScanningDefaultSetupDelete(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+}
+
+func TestAccGithubRepositoryCodeScanningDefaultSetup(t *testing.T) {
+	t.Run("configures code scanning default setup", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_repository" "test" {
+				name       = "%[1]scode-scanning-%[2]s"
+				visibility = "public"
+				auto_init  = true
+			}
+
+			resource "github_repository_file" "test" {
+				repository = github_repository.test.name
+				file       = "main.py"
+				content    = "print('hello')\n"
+			}
+
+			resource "github_repository_code_scanning_default_setup" "test" {
+				repository  = github_repository.test.name
+				query_suite = "%[3]s"
+				languages   = ["python"]
+
+				depends_on = [github_repository_file.test]
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "default"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_code_scanning_default_setup.test", "state", "configured"),
+						resource.TestCheckResourceAttr("github_repository_code_scanning_default_setup.test", "query_suite", "default"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "extended"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_code_scanning_default_setup.test", "query_suite", "extended"),
+					),
+				},
+				{
+					ResourceName:      "github_repository_code_scanning_default_setup.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
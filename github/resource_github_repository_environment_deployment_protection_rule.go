@@ -0,0 +1,241 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceGithubRepositoryEnvironmentDeploymentProtectionRule() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Description: "The name of the GitHub repository.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"repository_id": {
+				Description: "The ID of the GitHub repository.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"environment": {
+				Description: "The name of the environment.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"integration_id": {
+				Description:      "The ID of the GitHub App providing the custom deployment protection rule.",
+				Type:             schema.TypeInt,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"protection_rule_id": {
+				Description: "The ID of the custom deployment protection rule.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"app_slug": {
+				Description: "The slug of the GitHub App providing the custom deployment protection rule.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"node_id": {
+				Description: "The node ID of the custom deployment protection rule.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+
+		CustomizeDiff: customdiff.All(
+			diffRepository,
+		),
+
+		CreateContext: resourceGithubRepositoryEnvironmentDeploymentProtectionRuleCreate,
+		ReadContext:   resourceGithubRepositoryEnvironmentDeploymentProtectionRuleRead,
+		UpdateContext: resourceGithubRepositoryEnvironmentDeploymentProtectionRuleUpdate,
+		DeleteContext: resourceGithubRepositoryEnvironmentDeploymentProtectionRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubRepositoryEnvironmentDeploymentProtectionRuleImport,
+		},
+	}
+}
+
+func resourceGithubRepositoryEnvironmentDeploymentProtectionRuleCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	meta := m.(*Owner)
+	client := meta.v3client
+	owner := meta.name
+
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	integrationID := int64(d.Get("integration_id").(int))
+
+	createData := github.CustomDeploymentProtectionRuleRequest{
+		IntegrationID: new(integrationID),
+	}
+
+	rule, _, err := client.Repositories.CreateCustomDeploymentProtectionRule(ctx, owner, repoName, url.PathEscape(envName), &createData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := buildID(repoName, escapeIDPart(envName), strconv.FormatInt(integrationID, 10))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("repository_id", int(repo.GetID())); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setDeploymentProtectionRuleState(d, rule); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryEnvironmentDeploymentProtectionRuleRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	ctx = tflog.SetField(ctx, "id", d.Id())
+
+	meta := m.(*Owner)
+	client := meta.v3client
+	owner := meta.name
+
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	integrationID := int64(d.Get("integration_id").(int))
+
+	// Rules are looked up by app rather than by rule ID, as disabling and re-enabling an app outside of Terraform
+	// creates a new rule for the same app.
+	rules, _, err := client.Repositories.GetAllDeploymentProtectionRules(ctx, owner, repoName, url.PathEscape(envName))
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) {
+			if ghErr.Response.StatusCode == http.StatusNotModified {
+				return nil
+			}
+			if ghErr.Response.StatusCode == http.StatusNotFound {
+				tflog.Info(ctx, "Environment not found, removing deployment protection rule from state.", map[string]any{"repository": repoName, "environment": envName, "integration_id": integrationID})
+				d.SetId("")
+				return nil
+			}
+		}
+		return diag.FromErr(err)
+	}
+
+	for _, rule := range rules.ProtectionRules {
+		if rule.GetApp().GetID() == integrationID {
+			if err := setDeploymentProtectionRuleState(d, rule); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, "Deployment protection rule not found, removing from state.", map[string]any{"repository": repoName, "environment": envName, "integration_id": integrationID})
+	d.SetId("")
+	return nil
+}
+
+func resourceGithubRepositoryEnvironmentDeploymentProtectionRuleUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	// Only the repository can change in place, when it is renamed.
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	integrationID := d.Get("integration_id").(int)
+
+	id, err := buildID(repoName, escapeIDPart(envName), strconv.Itoa(integrationID))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return resourceGithubRepositoryEnvironmentDeploymentProtectionRuleRead(ctx, d, m)
+}
+
+func resourceGithubRepositoryEnvironmentDeploymentProtectionRuleDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	meta := m.(*Owner)
+	client := meta.v3client
+	owner := meta.name
+
+	repoName := d.Get("repository").(string)
+	envName := d.Get("environment").(string)
+	ruleID := int64(d.Get("protection_rule_id").(int))
+
+	_, err := client.Repositories.DisableCustomDeploymentProtectionRule(ctx, owner, repoName, url.PathEscape(envName), ruleID)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryEnvironmentDeploymentProtectionRuleImport(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+	meta := m.(*Owner)
+	client := meta.v3client
+	owner := meta.name
+
+	repoName, envNamePart, integrationIDStr, err := parseID3(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid id (%s), expected format <repository>:<environment>:<integration_id>", d.Id())
+	}
+
+	repo, _, err := client.Repositories.Get(ctx, owner, repoName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve repository %s: %w", repoName, err)
+	}
+
+	integrationID, err := strconv.Atoi(integrationIDStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid integration ID: %s", integrationIDStr)
+	}
+
+	if err := d.Set("repository", repoName); err != nil {
+		return nil, err
+	}
+	if err := d.Set("repository_id", int(repo.GetID())); err != nil {
+		return nil, err
+	}
+	if err := d.Set("environment", unescapeIDPart(envNamePart)); err != nil {
+		return nil, err
+	}
+	if err := d.Set("integration_id", integrationID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setDeploymentProtectionRuleState(d *schema.ResourceData, rule *github.CustomDeploymentProtectionRule) error {
+	if err := d.Set("protection_rule_id", int(rule.GetID())); err != nil {
+		return err
+	}
+	if err := d.Set("node_id", rule.GetNodeID()); err != nil {
+		return err
+	}
+	if err := d.Set("app_slug", rule.GetApp().GetSlug()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package github
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGithubRepositoryEnvironmentDeploymentProtectionRuleRead(t *testing.T) {
+	newMeta := func(t *testing.T, rules string) *Owner {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/test-owner/repo/environments/{environment}/deployment_protection_rules", func(w http.ResponseWriter, r *http.Request) {
+			if got := r.PathValue("environment"); got != "prod/eu" {
+				t.Errorf("got environment %q; want %q", got, "prod/eu")
+			}
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, rules)
+		})
+
+		return newTestOwner(t, mux, "test-owner", false)
+	}
+	newData := func(t *testing.T) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryEnvironmentDeploymentProtectionRule().Schema, map[string]any{
+			"repository":     "repo",
+			"environment":    "prod/eu",
+			"integration_id": 42,
+		})
+		d.SetId("repo:prod/eu:42")
+		return d
+	}
+
+	t.Run("finds the rule of the app", func(t *testing.T) {
+		meta := newMeta(t, `{"total_count": 2, "custom_deployment_protection_rules": [
+			{"id": 3, "node_id": "CDPR_3", "enabled": true, "app": {"id": 7, "slug": "other-app"}},
+			{"id": 4, "node_id": "CDPR_4", "enabled": true, "app": {"id": 42, "slug": "change-management"}}
+		]}`)
+		d := newData(t)
+
+		if diags := resourceGithubRepositoryEnvironmentDeploymentProtectionRuleRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("protection_rule_id").(int); got != 4 {
+			t.Errorf("got protection_rule_id %d; want 4", got)
+		}
+		if got := d.Get("app_slug").(string); got != "change-management" {
+			t.Errorf("got app_slug %q; want %q", got, "change-management")
+		}
+		if got := d.Get("node_id").(string); got != "CDPR_4" {
+			t.Errorf("got node_id %q; want %q", got, "CDPR_4")
+		}
+	})
+
+	t.Run("removes a rule which was disabled", func(t *testing.T) {
+		meta := newMeta(t, `{"total_count": 1, "custom_deployment_protection_rules": [
+			{"id": 3, "node_id": "CDPR_3", "enabled": true, "app": {"id": 7, "slug": "other-app"}}
+		]}`)
+		d := newData(t)
+
+		if diags := resourceGithubRepositoryEnvironmentDeploymentProtectionRuleRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if d.Id() != "" {
+			t.Errorf("expected the rule to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
@@ -0,0 +1,533 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/shurcooL/githubv4"
+)
+
+func resourceGithubRepositoryFileChangeRequest() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGithubRepositoryFileChangeRequestCreate,
+		ReadContext:   resourceGithubRepositoryFileChangeRequestRead,
+		UpdateContext: resourceGithubRepositoryFileChangeRequestUpdate,
+		DeleteContext: resourceGithubRepositoryFileChangeRequestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubRepositoryFileChangeRequestImport,
+		},
+
+		Description: "This resource allows you to change files within a GitHub repository through a pull request.",
+
+		CustomizeDiff: resourceGithubRepositoryFileChangeRequestDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The repository name",
+			},
+			"base_branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The branch the pull request is merged into, defaults to the repository's default branch",
+			},
+			"head_branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The branch the changes are written to, generated if not set. The branch must not exist yet, it is created and deleted by the resource",
+			},
+			"head_branch_created": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the head branch was created by the resource, in which case it is deleted with it",
+			},
+			"file": repositoryFilesSchema(),
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the pull request",
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The body of the pull request",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message of the changes, defaults to the title of the pull request",
+			},
+			"commit_author": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The commit author name, defaults to the authenticated user's name. GitHub app users may omit author and email information so GitHub can verify commits as the GitHub App.",
+				RequiredWith: []string{"commit_email"},
+			},
+			"commit_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The commit author email address, defaults to the authenticated user's email address. GitHub app users may omit author and email information so GitHub can verify commits as the GitHub App.",
+				RequiredWith: []string{"commit_author"},
+			},
+			"auto_merge": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to merge the pull request automatically once all requirements are met. The pull request is merged immediately if it already meets them.",
+			},
+			"merge_method": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(githubv4.PullRequestMergeMethodMerge),
+				Description:      "The merge method used by 'auto_merge'. Can be 'MERGE', 'SQUASH' or 'REBASE'.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{string(githubv4.PullRequestMergeMethodMerge), string(githubv4.PullRequestMergeMethodSquash), string(githubv4.PullRequestMergeMethodRebase)}, false)),
+			},
+			"number": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of the pull request",
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The node ID of the pull request",
+			},
+			"html_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the pull request",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the pull request - can be 'open' or 'merged'",
+			},
+			"mergeable_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mergeable state of an open pull request, e.g. 'clean', 'blocked', 'behind' or 'dirty'",
+			},
+			"head_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the head commit of the pull request",
+			},
+			"merge_commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the commit the pull request was merged with",
+			},
+		},
+	}
+}
+
+// resourceGithubRepositoryFileChangeRequestDiff replaces a merged change request when its files change, so that
+// the new changes are proposed in a new pull request.
+func resourceGithubRepositoryFileChangeRequestDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || d.Get("state").(string) != "merged" {
+		return nil
+	}
+	if d.HasChange("file") {
+		return d.ForceNew("file")
+	}
+	return nil
+}
+
+func resourceGithubRepositoryFileChangeRequestMessage(d *schema.ResourceData) string {
+	if message, ok := d.GetOk("commit_message"); ok {
+		return message.(string)
+	}
+	return d.Get("title").(string)
+}
+
+func resourceGithubRepositoryFileChangeRequestCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repo := d.Get("repository").(string)
+
+	files, err := expandRepositoryFiles(d.Get("file"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	base := d.Get("base_branch").(string)
+	if base == "" {
+		repoInfo, _, err := client.Repositories.Get(ctx, owner, repo)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		base = repoInfo.GetDefaultBranch()
+	}
+
+	head := d.Get("head_branch").(string)
+	if head == "" {
+		head = id.PrefixedUniqueId("terraform/file-change-")
+	}
+
+	// An existing branch isn't owned by the resource, so it's never reset to the changes.
+	_, _, err = client.Git.GetRef(ctx, owner, repo, "heads/"+head)
+	if err == nil {
+		return diag.Errorf("branch %s already exists in %s/%s, set head_branch to a branch which doesn't exist yet", head, owner, repo)
+	}
+	var ghErr *github.ErrorResponse
+	if !errors.As(err, &ghErr) || ghErr.Response.StatusCode != http.StatusNotFound {
+		return diag.FromErr(err)
+	}
+
+	parent, err := getBranchHeadCommit(ctx, client, owner, repo, base)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	commit, err := createRepositoryFilesCommit(ctx, client, owner, repo, parent, files, nil, resourceGithubRepositoryFileChangeRequestMessage(d), resourceGithubRepositoryFilesAuthor(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if commit == nil {
+		return diag.Errorf("the files in branch %s of %s/%s already match the configuration, there is nothing to change", base, owner, repo)
+	}
+
+	if _, _, err := client.Git.CreateRef(ctx, owner, repo, github.CreateRef{Ref: "refs/heads/" + head, SHA: commit.GetSHA()}); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating branch %s of %s/%s: %w", head, owner, repo, err))
+	}
+
+	pullRequest, _, err := client.PullRequests.Create(ctx, owner, repo, &github.NewPullRequest{
+		Title: new(d.Get("title").(string)),
+		Head:  new(head),
+		Base:  new(base),
+		Body:  new(d.Get("body").(string)),
+	})
+	if err != nil {
+		// The branch was just created by the resource, so it's deleted rather than left behind without a pull request.
+		if _, deleteErr := client.Git.DeleteRef(ctx, owner, repo, "heads/"+head); deleteErr != nil {
+			log.Printf("[WARN] Error deleting branch %s of %s/%s: %s", head, owner, repo, deleteErr)
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(buildTwoPartID(repo, strconv.Itoa(pullRequest.GetNumber())))
+
+	if err := d.Set("base_branch", base); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("head_branch", head); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("head_branch_created", true); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("auto_merge").(bool) {
+		if err := enablePullRequestAutoMerge(ctx, meta, repo, pullRequest, d.Get("merge_method").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGithubRepositoryFileChangeRequestRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryFileChangeRequestRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repo, number, err := parseFileChangeRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	pullRequest, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+	if err != nil {
+		return diag.FromErr(deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "file change request %s/%s#%d", owner, repo, number))
+	}
+
+	if pullRequest.GetState() == "closed" && !pullRequest.GetMerged() {
+		log.Printf("[INFO] Removing file change request %s from state because its pull request was closed without merging", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("repository", repo); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("base_branch", pullRequest.GetBase().GetRef()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("head_branch", pullRequest.GetHead().GetRef()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("title", pullRequest.GetTitle()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("body", pullRequest.GetBody()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("number", pullRequest.GetNumber()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("node_id", pullRequest.GetNodeID()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("html_url", pullRequest.GetHTMLURL()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("head_sha", pullRequest.GetHead().GetSHA()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("merge_commit_sha", pullRequest.GetMergeCommitSHA()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Once merged, the changes are part of the base branch and the resource is in sync.
+	if pullRequest.GetMerged() {
+		if err := d.Set("state", "merged"); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("mergeable_state", ""); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	if err := d.Set("state", pullRequest.GetState()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mergeable_state", pullRequest.GetMergeableState()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("auto_merge", pullRequest.AutoMerge != nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if pullRequest.AutoMerge != nil {
+		if err := d.Set("merge_method", strings.ToUpper(pullRequest.AutoMerge.GetMergeMethod())); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	files, err := expandRepositoryFiles(d.Get("file"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	commit, _, err := client.Git.GetCommit(ctx, owner, repo, pullRequest.GetHead().GetSHA())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	result, err := flattenRepositoryFiles(ctx, client, owner, repo, commit, files)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("file", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryFileChangeRequestUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repo, number, err := parseFileChangeRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	head := d.Get("head_branch").(string)
+
+	if d.HasChange("file") {
+		o, n := d.GetChange("file")
+		oldFiles, err := expandRepositoryFiles(o)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		files, err := expandRepositoryFiles(n)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var remove []string
+		for path := range oldFiles {
+			if _, ok := files[path]; !ok {
+				remove = append(remove, path)
+			}
+		}
+		slices.Sort(remove)
+
+		parent, err := getBranchHeadCommit(ctx, client, owner, repo, head)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		commit, err := createRepositoryFilesCommit(ctx, client, owner, repo, parent, files, remove, resourceGithubRepositoryFileChangeRequestMessage(d), resourceGithubRepositoryFilesAuthor(d))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if commit != nil {
+			if _, _, err := client.Git.UpdateRef(ctx, owner, repo, "heads/"+head, github.UpdateRef{SHA: commit.GetSHA()}); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating branch %s of %s/%s: %w", head, owner, repo, err))
+			}
+		}
+	}
+
+	if d.HasChanges("title", "body") {
+		update := &github.PullRequest{
+			Title: new(d.Get("title").(string)),
+			Body:  new(d.Get("body").(string)),
+		}
+		if _, _, err := client.PullRequests.Edit(ctx, owner, repo, number, update); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("auto_merge", "merge_method") && d.Get("state").(string) == "open" {
+		pullRequest, _, err := client.PullRequests.Get(ctx, owner, repo, number)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if d.Get("auto_merge").(bool) {
+			err = enablePullRequestAutoMerge(ctx, meta, repo, pullRequest, d.Get("merge_method").(string))
+		} else {
+			err = disablePullRequestAutoMerge(ctx, meta, pullRequest)
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGithubRepositoryFileChangeRequestRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryFileChangeRequestDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repo, number, err := parseFileChangeRequestID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Merged changes are not reverted, only a pending pull request is closed.
+	if d.Get("state").(string) == "open" {
+		update := &github.PullRequest{State: new("closed")}
+		if _, _, err := client.PullRequests.Edit(ctx, owner, repo, number, update); err != nil {
+			return diag.FromErr(handleArchivedRepoDelete(err, "file change request", d.Id(), owner, repo))
+		}
+	}
+
+	// Branches which existed before the resource, such as imported ones, are kept.
+	if !d.Get("head_branch_created").(bool) {
+		return nil
+	}
+
+	_, err = client.Git.DeleteRef(ctx, owner, repo, "heads/"+d.Get("head_branch").(string))
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && (ghErr.Response.StatusCode == http.StatusNotFound || ghErr.Response.StatusCode == http.StatusUnprocessableEntity) {
+			return nil
+		}
+	}
+	return diag.FromErr(handleArchivedRepoDelete(err, "file change request branch", d.Get("head_branch").(string), owner, repo))
+}
+
+func resourceGithubRepositoryFileChangeRequestImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	if _, _, err := parseFileChangeRequestID(d.Id()); err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("merge_method", string(githubv4.PullRequestMergeMethodMerge)); err != nil {
+		return nil, err
+	}
+	if err := d.Set("head_branch_created", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseFileChangeRequestID(id string) (string, int, error) {
+	repo, strNumber, err := parseTwoPartID(id, "repository", "number")
+	if err != nil {
+		return "", 0, err
+	}
+
+	number, err := strconv.Atoi(strNumber)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid PR number %s: %w", strNumber, err)
+	}
+
+	return repo, number, nil
+}
+
+// enablePullRequestAutoMerge enables auto-merge for the pull request. A pull request that already meets all
+// requirements cannot be set to auto-merge, so it is merged right away instead.
+func enablePullRequestAutoMerge(ctx context.Context, meta any, repo string, pullRequest *github.PullRequest, mergeMethod string) error {
+	var mutation struct {
+		EnablePullRequestAutoMerge struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"enablePullRequestAutoMerge(input:$input)"`
+	}
+	method := githubv4.PullRequestMergeMethod(mergeMethod)
+	input := githubv4.EnablePullRequestAutoMergeInput{
+		PullRequestID: githubv4.ID(pullRequest.GetNodeID()),
+		MergeMethod:   &method,
+	}
+
+	err := meta.(*Owner).v4client.Mutate(ctx, &mutation, input, nil)
+	if err == nil {
+		return nil
+	}
+
+	var query struct {
+		Node struct {
+			PullRequest struct {
+				MergeStateStatus githubv4.MergeStateStatus
+			} `graphql:"... on PullRequest"`
+		} `graphql:"node(id: $id)"`
+	}
+	variables := map[string]any{
+		"id": githubv4.ID(pullRequest.GetNodeID()),
+	}
+	if queryErr := meta.(*Owner).v4client.Query(ctx, &query, variables); queryErr != nil || query.Node.PullRequest.MergeStateStatus != githubv4.MergeStateStatusClean {
+		return err
+	}
+
+	log.Printf("[INFO] Merging pull request #%d of %s because it meets all merge requirements", pullRequest.GetNumber(), repo)
+	_, _, err = meta.(*Owner).v3client.PullRequests.Merge(ctx, meta.(*Owner).name, repo, pullRequest.GetNumber(), "", &github.PullRequestOptions{
+		MergeMethod: strings.ToLower(mergeMethod),
+		SHA:         pullRequest.GetHead().GetSHA(),
+	})
+	return err
+}
+
+func disablePullRequestAutoMerge(ctx context.Context, meta any, pullRequest *github.PullRequest) error {
+	if pullRequest.AutoMerge == nil {
+		return nil
+	}
+
+	var mutation struct {
+		DisablePullRequestAutoMerge struct {
+			ClientMutationID githubv4.String `graphql:"clientMutationId"`
+		} `graphql:"disablePullRequestAutoMerge(input:$input)"`
+	}
+	input := githubv4.DisablePullRequestAutoMergeInput{
+		PullRequestID: githubv4.ID(pullRequest.GetNodeID()),
+	}
+
+	return meta.(*Owner).v4client.Mutate(ctx, &mutation, input, nil)
+}
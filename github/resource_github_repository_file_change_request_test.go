@@ -0,0 +1,264 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubRepositoryFileChangeRequestRead(t *testing.T) {
+	newMeta := func(t *testing.T, pullRequest string) *Owner {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/test-owner/repo/pulls/3", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, pullRequest)
+		})
+		mux.HandleFunc("GET /repos/test-owner/repo/git/commits/h1", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `{"sha": "h1", "tree": {"sha": "t1"}}`)
+		})
+		mux.HandleFunc("GET /repos/test-owner/repo/git/trees/t1", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, fmt.Sprintf(`{"sha": "t1", "tree": [{"path": "a.txt", "mode": "100644", "type": "blob", "sha": "%s"}]}`, gitBlobSHA("a")))
+		})
+
+		return newTestOwner(t, mux, "test-owner", false)
+	}
+	newData := func(t *testing.T) *schema.ResourceData {
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryFileChangeRequest().Schema, map[string]any{
+			"repository": "repo",
+			"title":      "Update files",
+			"file": []any{
+				map[string]any{"path": "a.txt", "content": "a"},
+				map[string]any{"path": "b.txt", "content": "b"},
+			},
+		})
+		d.SetId("repo:3")
+		return d
+	}
+
+	t.Run("detects drift of an open pull request", func(t *testing.T) {
+		meta := newMeta(t, `{"number": 3, "state": "open", "merged": false, "mergeable_state": "blocked", "head": {"ref": "terraform/x", "sha": "h1"}, "base": {"ref": "main"}}`)
+		d := newData(t)
+
+		if diags := resourceGithubRepositoryFileChangeRequestRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("state").(string); got != "open" {
+			t.Errorf("got state %q; want %q", got, "open")
+		}
+		if got := d.Get("mergeable_state").(string); got != "blocked" {
+			t.Errorf("got mergeable_state %q; want %q", got, "blocked")
+		}
+		if got := d.Get("file").(*schema.Set).Len(); got != 1 {
+			t.Errorf("got %d files; want 1 as b.txt is missing from the head branch", got)
+		}
+	})
+
+	t.Run("is in sync once merged", func(t *testing.T) {
+		meta := newMeta(t, `{"number": 3, "state": "closed", "merged": true, "merge_commit_sha": "m1", "head": {"ref": "terraform/x", "sha": "h1"}, "base": {"ref": "main"}}`)
+		d := newData(t)
+
+		if diags := resourceGithubRepositoryFileChangeRequestRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("state").(string); got != "merged" {
+			t.Errorf("got state %q; want %q", got, "merged")
+		}
+		if got := d.Get("merge_commit_sha").(string); got != "m1" {
+			t.Errorf("got merge_commit_sha %q; want %q", got, "m1")
+		}
+		if got := d.Get("file").(*schema.Set).Len(); got != 2 {
+			t.Errorf("got %d files; want 2", got)
+		}
+	})
+
+	t.Run("removes a pull request closed without merging", func(t *testing.T) {
+		meta := newMeta(t, `{"number": 3, "state": "closed", "merged": false, "head": {"ref": "terraform/x", "sha": "h1"}, "base": {"ref": "main"}}`)
+		d := newData(t)
+
+		if diags := resourceGithubRepositoryFileChangeRequestRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if d.Id() != "" {
+			t.Errorf("expected the change request to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
+
+func TestGithubRepositoryFileChangeRequestCreate(t *testing.T) {
+	t.Run("refuses to reuse an existing branch", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/test-owner/repo/git/ref/heads/feature", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			mustWrite(w, `{"ref": "refs/heads/feature", "object": {"sha": "f1"}}`)
+		})
+		mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		})
+		meta := newTestOwner(t, mux, "test-owner", false)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryFileChangeRequest().Schema, map[string]any{
+			"repository":  "repo",
+			"base_branch": "main",
+			"head_branch": "feature",
+			"title":       "Update files",
+			"file":        []any{map[string]any{"path": "a.txt", "content": "a"}},
+		})
+
+		diags := resourceGithubRepositoryFileChangeRequestCreate(t.Context(), d, meta)
+		if !diags.HasError() || !strings.Contains(diags[0].Summary, "branch feature already exists in test-owner/repo") {
+			t.Fatalf("got %v; want an error about the existing branch", diags)
+		}
+	})
+}
+
+func TestGithubRepositoryFileChangeRequestDelete(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		created       bool
+		wantDeleteRef bool
+	}{
+		{name: "deletes a branch created by the resource", created: true, wantDeleteRef: true},
+		{name: "keeps a branch not created by the resource", created: false, wantDeleteRef: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted := false
+			mux := http.NewServeMux()
+			mux.HandleFunc("PATCH /repos/test-owner/repo/pulls/3", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				mustWrite(w, `{"number": 3, "state": "closed"}`)
+			})
+			mux.HandleFunc("DELETE /repos/test-owner/repo/git/refs/heads/feature", func(w http.ResponseWriter, _ *http.Request) {
+				deleted = true
+				w.WriteHeader(http.StatusNoContent)
+			})
+			meta := newTestOwner(t, mux, "test-owner", false)
+
+			d := schema.TestResourceDataRaw(t, resourceGithubRepositoryFileChangeRequest().Schema, map[string]any{
+				"repository":  "repo",
+				"head_branch": "feature",
+				"title":       "Update files",
+			})
+			d.SetId("repo:3")
+			if err := d.Set("state", "open"); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.Set("head_branch_created", tt.created); err != nil {
+				t.Fatal(err)
+			}
+
+			if diags := resourceGithubRepositoryFileChangeRequestDelete(t.Context(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if deleted != tt.wantDeleteRef {
+				t.Errorf("got branch deleted %t; want %t", deleted, tt.wantDeleteRef)
+			}
+		})
+	}
+}
+
+func TestEnablePullRequestAutoMerge(t *testing.T) {
+	for _, tt := range []struct {
+		name             string
+		mergeStateStatus string
+		wantMerged       bool
+		wantErr          bool
+	}{
+		{name: "merges a pull request which meets all requirements", mergeStateStatus: "CLEAN", wantMerged: true},
+		{name: "returns the error otherwise", mergeStateStatus: "BLOCKED", wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := false
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+				var body struct {
+					Query string `json:"query"`
+				}
+				b, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(b, &body); err != nil {
+					t.Error(err)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				if strings.HasPrefix(body.Query, "mutation") {
+					mustWrite(w, `{"data": null, "errors": [{"type": "UNPROCESSABLE", "message": "Pull request is in clean status"}]}`)
+					return
+				}
+				mustWrite(w, fmt.Sprintf(`{"data": {"node": {"mergeStateStatus": "%s"}}}`, tt.mergeStateStatus))
+			})
+			mux.HandleFunc("PUT /repos/test-owner/repo/pulls/3/merge", func(w http.ResponseWriter, _ *http.Request) {
+				merged = true
+				w.Header().Set("Content-Type", "application/json")
+				mustWrite(w, `{"merged": true}`)
+			})
+			meta := newTestOwner(t, mux, "test-owner", false)
+
+			pullRequest := &github.PullRequest{Number: new(3), NodeID: new("PR_1"), Head: &github.PullRequestBranch{SHA: new("h1")}}
+			err := enablePullRequestAutoMerge(t.Context(), meta, "repo", pullRequest, "SQUASH")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v; want error %t", err, tt.wantErr)
+			}
+			if merged != tt.wantMerged {
+				t.Errorf("got merged %t; want %t", merged, tt.wantMerged)
+			}
+		})
+	}
+}
+
+func TestAccGithubRepositoryFileChangeRequest(t *testing.T) {
+	t.Run("opens and updates a pull request", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_repository" "test" {
+				name      = "%[1]srepo-file-change-%[2]s"
+				auto_init = true
+			}
+
+			resource "github_repository_file_change_request" "test" {
+				repository = github_repository.test.name
+				title      = "Update CODEOWNERS"
+
+				file {
+					path    = ".github/CODEOWNERS"
+					content = "%[3]s"
+				}
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "* @octocat"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_file_change_request.test", "state", "open"),
+						resource.TestCheckResourceAttr("github_repository_file_change_request.test", "base_branch", "main"),
+						resource.TestCheckResourceAttrSet("github_repository_file_change_request.test", "number"),
+						resource.TestCheckResourceAttrSet("github_repository_file_change_request.test", "head_branch"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "* @hubot"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_file_change_request.test", "state", "open"),
+						resource.TestCheckTypeSetElemNestedAttrs("github_repository_file_change_request.test", "file.*", map[string]string{
+							"content": "* @hubot",
+						}),
+					),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,389 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryFiles() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGithubRepositoryFilesCreate,
+		ReadContext:   resourceGithubRepositoryFilesRead,
+		UpdateContext: resourceGithubRepositoryFilesUpdate,
+		DeleteContext: resourceGithubRepositoryFilesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubRepositoryFilesImport,
+		},
+
+		Description: "This resource allows you to manage a set of files within a GitHub repository in a single commit.",
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The repository name",
+			},
+			"repository_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The repository ID",
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The branch name, defaults to the repository's default branch",
+			},
+			"file": repositoryFilesSchema(),
+			"commit_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SHA of the last commit that modified the files",
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting the files",
+			},
+			"commit_author": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The commit author name, defaults to the authenticated user's name. GitHub app users may omit author and email information so GitHub can verify commits as the GitHub App.",
+				RequiredWith: []string{"commit_email"},
+			},
+			"commit_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The commit author email address, defaults to the authenticated user's email address. GitHub app users may omit author and email information so GitHub can verify commits as the GitHub App.",
+				RequiredWith: []string{"commit_author"},
+			},
+			"overwrite_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable overwriting existing files, defaults to \"false\"",
+				Default:     false,
+			},
+			"autocreate_branch": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Description:      "Automatically create the branch if it could not be found.",
+				Default:          false,
+				DiffSuppressFunc: autoBranchDiffSuppressFunc,
+			},
+			"autocreate_branch_source_branch": {
+				Type:             schema.TypeString,
+				Default:          "main",
+				Optional:         true,
+				Description:      "The branch name to start from, if 'autocreate_branch' is set. Defaults to 'main'.",
+				RequiredWith:     []string{"autocreate_branch"},
+				DiffSuppressFunc: autoBranchDiffSuppressFunc,
+			},
+			"autocreate_branch_source_sha": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "The commit hash to start from, if 'autocreate_branch' is set. Defaults to the tip of 'autocreate_branch_source_branch'. If provided, 'autocreate_branch_source_branch' is ignored.",
+				RequiredWith:     []string{"autocreate_branch"},
+				DiffSuppressFunc: autoBranchDiffSuppressFunc,
+			},
+		},
+		CustomizeDiff: diffRepository,
+	}
+}
+
+func repositoryFilesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Required:    true,
+		Description: "The files to manage",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"path": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The file path to manage",
+				},
+				"content": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The file's content",
+				},
+			},
+		},
+	}
+}
+
+// expandRepositoryFiles returns the configured files keyed by path.
+func expandRepositoryFiles(v any) (map[string]string, error) {
+	files := make(map[string]string)
+	for _, f := range v.(*schema.Set).List() {
+		file := f.(map[string]any)
+		path := file["path"].(string)
+		if _, ok := files[path]; ok {
+			return nil, fmt.Errorf("file %q is configured more than once", path)
+		}
+		files[path] = file["content"].(string)
+	}
+	return files, nil
+}
+
+func resourceGithubRepositoryFilesAuthor(d *schema.ResourceData) *github.CommitAuthor {
+	commitAuthor, hasCommitAuthor := d.GetOk("commit_author")
+	commitEmail, hasCommitEmail := d.GetOk("commit_email")
+	if !hasCommitAuthor || !hasCommitEmail {
+		return nil
+	}
+
+	return &github.CommitAuthor{
+		Name:  new(commitAuthor.(string)),
+		Email: new(commitEmail.(string)),
+	}
+}
+
+func resourceGithubRepositoryFilesMessage(d *schema.ResourceData, defaultMessage string) string {
+	if message, ok := d.GetOk("commit_message"); ok {
+		return message.(string)
+	}
+	return defaultMessage
+}
+
+// resourceGithubRepositoryFilesCommit commits the changes to the branch and records the resulting commit.
+func resourceGithubRepositoryFilesCommit(ctx context.Context, d *schema.ResourceData, meta any, files map[string]string, remove []string, message string) error {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repo := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+
+	head, err := getBranchHeadCommit(ctx, client, owner, repo, branch)
+	if err != nil {
+		return err
+	}
+
+	commit, err := createRepositoryFilesCommit(ctx, client, owner, repo, head, files, remove, message, resourceGithubRepositoryFilesAuthor(d))
+	if err != nil {
+		return err
+	}
+	if commit == nil {
+		return d.Set("commit_sha", head.GetSHA())
+	}
+
+	// The update is not forced, so it fails rather than discarding commits pushed since the head was read.
+	if _, _, err := client.Git.UpdateRef(ctx, owner, repo, "heads/"+branch, github.UpdateRef{SHA: commit.GetSHA()}); err != nil {
+		return fmt.Errorf("error updating branch %s of %s/%s: %w", branch, owner, repo, err)
+	}
+
+	return d.Set("commit_sha", commit.GetSHA())
+}
+
+func resourceGithubRepositoryFilesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repo := d.Get("repository").(string)
+
+	ctx = tflog.SetField(ctx, "repository", repo)
+	ctx = tflog.SetField(ctx, "owner", owner)
+
+	files, err := expandRepositoryFiles(d.Get("file"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	repoInfo, _, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	branch := repoInfo.GetDefaultBranch()
+	if branchFieldVal, ok := d.GetOk("branch"); ok {
+		branch = branchFieldVal.(string)
+		if err := checkRepositoryBranchExists(ctx, client, owner, repo, branch); err != nil {
+			if !d.Get("autocreate_branch").(bool) {
+				return diag.FromErr(err)
+			}
+			if err := resourceGithubRepositoryFileCreateBranch(ctx, d, meta); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	if err := d.Set("branch", branch); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if !d.Get("overwrite_on_create").(bool) {
+		head, err := getBranchHeadCommit(ctx, client, owner, repo, branch)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		paths := make([]string, 0, len(files))
+		for path := range files {
+			paths = append(paths, path)
+		}
+		existing, err := getRepositoryTreeEntries(ctx, client, owner, repo, head, paths)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, path := range paths {
+			if entry, ok := existing[path]; ok && entry.GetSHA() != gitBlobSHA(files[path]) {
+				return diag.Errorf("refusing to overwrite existing file %s: configure `overwrite_on_create` to `true` to override", path)
+			}
+		}
+	}
+
+	tflog.Debug(ctx, "Committing repository files", map[string]any{
+		"branch": branch,
+		"files":  len(files),
+	})
+	if err := resourceGithubRepositoryFilesCommit(ctx, d, meta, files, nil, resourceGithubRepositoryFilesMessage(d, "Add files")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := buildID(repo, branch)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	if err := d.Set("repository_id", int(repoInfo.GetID())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubRepositoryFilesRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryFilesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repo := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+
+	ctx = tflog.SetField(ctx, "repository", repo)
+	ctx = tflog.SetField(ctx, "branch", branch)
+	ctx = tflog.SetField(ctx, "owner", owner)
+
+	head, err := getBranchHeadCommit(ctx, client, owner, repo, branch)
+	if err != nil {
+		return diag.FromErr(deleteResourceOn404AndSwallow304OtherwiseReturnError(err, d, "repository files %s/%s:%s", owner, repo, branch))
+	}
+
+	files, err := expandRepositoryFiles(d.Get("file"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	result, err := flattenRepositoryFiles(ctx, client, owner, repo, head, files)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("file", result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryFilesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	repo := d.Get("repository").(string)
+	branch := d.Get("branch").(string)
+
+	if d.HasChange("file") {
+		o, n := d.GetChange("file")
+		oldFiles, err := expandRepositoryFiles(o)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		files, err := expandRepositoryFiles(n)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		var remove []string
+		for path := range oldFiles {
+			if _, ok := files[path]; !ok {
+				remove = append(remove, path)
+			}
+		}
+		slices.Sort(remove)
+
+		if err := resourceGithubRepositoryFilesCommit(ctx, d, meta, files, remove, resourceGithubRepositoryFilesMessage(d, "Update files")); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("repository") {
+		id, err := buildID(repo, branch)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		d.SetId(id)
+	}
+
+	return resourceGithubRepositoryFilesRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryFilesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	owner := meta.(*Owner).name
+	repo := d.Get("repository").(string)
+
+	files, err := expandRepositoryFiles(d.Get("file"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remove := make([]string, 0, len(files))
+	for path := range files {
+		remove = append(remove, path)
+	}
+	slices.Sort(remove)
+
+	err = resourceGithubRepositoryFilesCommit(ctx, d, meta, nil, remove, resourceGithubRepositoryFilesMessage(d, "Delete files"))
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+	}
+	return diag.FromErr(handleArchivedRepoDelete(err, "repository files", d.Id(), owner, repo))
+}
+
+func resourceGithubRepositoryFilesImport(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+	repo, branch, err := parseID2(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID specified. Supplied ID must be written as <repository>:<branch>. %w", err)
+	}
+
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+
+	repoInfo, _, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("repository", repo); err != nil {
+		return nil, err
+	}
+	if err := d.Set("branch", branch); err != nil {
+		return nil, err
+	}
+	if err := d.Set("repository_id", int(repoInfo.GetID())); err != nil {
+		return nil, err
+	}
+	if err := d.Set("overwrite_on_create", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,109 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccGithubRepositoryFiles(t *testing.T) {
+	t.Run("creates, updates and deletes files in single commits", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("%srepo-files-%s", testResourcePrefix, randomID)
+		config := `
+			resource "github_repository" "test" {
+				name      = "%s"
+				auto_init = true
+			}
+
+			resource "github_repository_files" "test" {
+				repository     = github_repository.test.name
+				commit_message = "Managed by Terraform"
+				commit_author  = "Terraform User"
+				commit_email   = "terraform@example.com"
+
+				%s
+			}
+		`
+		initial := `
+				file {
+					path    = ".github/CODEOWNERS"
+					content = "* @octocat\n"
+				}
+
+				file {
+					path    = "docs/README.md"
+					content = "# Docs\n"
+				}
+		`
+		updated := `
+				file {
+					path    = ".github/CODEOWNERS"
+					content = "* @octocat @hubot\n"
+				}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, repoName, initial),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_files.test", "branch", "main"),
+						resource.TestCheckResourceAttr("github_repository_files.test", "file.#", "2"),
+						resource.TestCheckTypeSetElemNestedAttrs("github_repository_files.test", "file.*", map[string]string{
+							"path":    "docs/README.md",
+							"content": "# Docs\n",
+						}),
+						resource.TestCheckResourceAttrSet("github_repository_files.test", "commit_sha"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, repoName, updated),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_files.test", "file.#", "1"),
+						resource.TestCheckTypeSetElemNestedAttrs("github_repository_files.test", "file.*", map[string]string{
+							"path":    ".github/CODEOWNERS",
+							"content": "* @octocat @hubot\n",
+						}),
+					),
+				},
+			},
+		})
+	})
+
+	t.Run("refuses to overwrite existing files", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("%srepo-files-%s", testResourcePrefix, randomID)
+		config := fmt.Sprintf(`
+			resource "github_repository" "test" {
+				name      = "%s"
+				auto_init = true
+			}
+
+			resource "github_repository_files" "test" {
+				repository = github_repository.test.name
+
+				file {
+					path    = "README.md"
+					content = "overwritten"
+				}
+			}
+		`, repoName)
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config:      config,
+					ExpectError: regexp.MustCompile("refusing to overwrite existing file README.md"),
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,107 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryInteractionLimit() *schema.Resource {
+	s := interactionLimitSchema("repository")
+	s["repository"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "The name of the public repository.",
+	}
+
+	return &schema.Resource{
+		Description: "Limit who can interact with a public repository for a while. Expired limits are treated as removed.",
+
+		CreateContext: resourceGithubRepositoryInteractionLimitCreateOrUpdate,
+		ReadContext:   resourceGithubRepositoryInteractionLimitRead,
+		UpdateContext: resourceGithubRepositoryInteractionLimitCreateOrUpdate,
+		DeleteContext: resourceGithubRepositoryInteractionLimitDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubRepositoryInteractionLimitImport,
+		},
+
+		Schema: s,
+	}
+}
+
+func resourceGithubRepositoryInteractionLimitCreateOrUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	if err := setInteractionLimit(ctx, client, fmt.Sprintf("repos/%s/%s/interaction-limits", owner, repoName), d); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting the interaction limit of repository %s/%s: %w", owner, repoName, err))
+	}
+	d.SetId(repoName)
+
+	return resourceGithubRepositoryInteractionLimitRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryInteractionLimitRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	restriction, _, err := client.Interactions.GetRestrictionsForRepo(ctx, owner, repoName)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing interaction limit of repository %s/%s from state because it no longer exists in GitHub", owner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	active, err := flattenInteractionLimit(d, restriction, "repository")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !active {
+		log.Printf("[INFO] Removing interaction limit of repository %s/%s from state because it expired or was removed", owner, repoName)
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryInteractionLimitDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	_, err := client.Interactions.RemoveRestrictionsFromRepo(ctx, owner, repoName)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(handleArchivedRepoDelete(err, "interaction limit", repoName, owner, repoName))
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryInteractionLimitImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := d.Set("repository", d.Id()); err != nil {
+		return nil, err
+	}
+	// The expiry isn't returned by GitHub.
+	if err := d.Set("expiry", "one_day"); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,132 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubRepositoryInteractionLimitRead(t *testing.T) {
+	future := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	past := time.Now().Add(-time.Hour).UTC()
+
+	for _, tc := range []struct {
+		name     string
+		response string
+		active   bool
+	}{
+		{"active limit", fmt.Sprintf(`{"limit": "collaborators_only", "origin": "repository", "expires_at": %q}`, future.Format(time.RFC3339)), true},
+		{"expired limit", fmt.Sprintf(`{"limit": "collaborators_only", "origin": "repository", "expires_at": %q}`, past.Format(time.RFC3339)), false},
+		{"organization limit", fmt.Sprintf(`{"limit": "existing_users", "origin": "organization", "expires_at": %q}`, future.Format(time.RFC3339)), false},
+		{"no limit", `{}`, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /repos/test-owner/repo/interaction-limits", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				mustWrite(w, tc.response)
+			})
+
+			meta := newTestOwner(t, mux, "test-owner", false)
+
+			d := schema.TestResourceDataRaw(t, resourceGithubRepositoryInteractionLimit().Schema, map[string]any{
+				"repository": "repo",
+				"limit":      "collaborators_only",
+			})
+			d.SetId("repo")
+
+			if diags := resourceGithubRepositoryInteractionLimitRead(t.Context(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if active := d.Id() != ""; active != tc.active {
+				t.Fatalf("got active %t; want %t", active, tc.active)
+			}
+			if tc.active && d.Get("expires_at") != future.Format(time.RFC3339) {
+				t.Errorf("got expires_at %v; want %s", d.Get("expires_at"), future.Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestGithubRepositoryInteractionLimitCreate(t *testing.T) {
+	var body map[string]string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /repos/test-owner/repo/interaction-limits", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"limit": "contributors_only", "origin": "repository"}`)
+	})
+	mux.HandleFunc("GET /repos/test-owner/repo/interaction-limits", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{"limit": "contributors_only", "origin": "repository", "expires_at": "2099-01-01T00:00:00Z"}`)
+	})
+
+	meta := newTestOwner(t, mux, "test-owner", false)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryInteractionLimit().Schema, map[string]any{
+		"repository": "repo",
+		"limit":      "contributors_only",
+		"expiry":     "one_week",
+	})
+
+	if diags := resourceGithubRepositoryInteractionLimitCreateOrUpdate(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if body["limit"] != "contributors_only" || body["expiry"] != "one_week" {
+		t.Errorf("got request %v", body)
+	}
+	if d.Id() != "repo" || d.Get("expires_at") != "2099-01-01T00:00:00Z" {
+		t.Errorf("got ID %q and expires_at %v", d.Id(), d.Get("expires_at"))
+	}
+}
+
+func TestAccGithubRepositoryInteractionLimit(t *testing.T) {
+	t.Run("limits interactions with a repository", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_repository" "test" {
+				name       = "%[1]sinteraction-limit-%[2]s"
+				visibility = "public"
+			}
+
+			resource "github_repository_interaction_limit" "test" {
+				repository = github_repository.test.name
+				limit      = "%[3]s"
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "collaborators_only"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_interaction_limit.test", "limit", "collaborators_only"),
+						resource.TestCheckResourceAttrSet("github_repository_interaction_limit.test", "expires_at"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "existing_users"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_interaction_limit.test", "limit", "existing_users"),
+					),
+				},
+				{
+					ResourceName:      "github_repository_interaction_limit.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -0,0 +1,299 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceGithubRepositoryPages() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manage the GitHub Pages site of a repository.",
+
+		CreateContext: resourceGithubRepositoryPagesCreate,
+		ReadContext:   resourceGithubRepositoryPagesRead,
+		UpdateContext: resourceGithubRepositoryPagesUpdate,
+		DeleteContext: resourceGithubRepositoryPagesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGithubRepositoryPagesImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: resourceGithubRepositoryPagesDiff,
+
+		Schema: map[string]*schema.Schema{
+			"repository": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the repository.",
+			},
+			"build_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "legacy",
+				Description:      "How the site is built. Can be one of: 'legacy', to build it from a branch, or 'workflow', to build it with a GitHub Actions workflow.",
+				ValidateDiagFunc: validateValueFunc([]string{"legacy", "workflow"}),
+			},
+			"source": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The source branch and directory of the site. Required when 'build_type' is 'legacy'.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"branch": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The repository branch used to publish the site's source files.",
+						},
+						"path": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          "/",
+							Description:      "The repository directory from which the site publishes. Can be one of: '/' or '/docs'.",
+							ValidateDiagFunc: validateValueFunc([]string{"/", "/docs"}),
+						},
+					},
+				},
+			},
+			"cname": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The custom domain of the site.",
+			},
+			"https_enforced": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether HTTPS is enforced for the site. HTTPS is enforced once the certificate of the custom domain is issued.",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the site is public, or only visible to users with read access to the repository. Only private sites of repositories of organizations on GitHub Enterprise Cloud can be private.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API URL of the site.",
+			},
+			"html_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the site.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The build status of the site, such as 'building' or 'built'.",
+			},
+			"custom_404": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the site has a custom 404 page.",
+			},
+			"https_certificate_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the certificate of the custom domain, such as 'new', 'approved' or 'errored'.",
+			},
+		},
+	}
+}
+
+func resourceGithubRepositoryPagesCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	update := expandRepositoryPagesUpdate(d)
+	_, _, err := client.Repositories.EnablePages(ctx, owner, repoName, &github.Pages{BuildType: update.BuildType, Source: update.Source})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error enabling GitHub Pages of repository %s/%s: %w", owner, repoName, err))
+	}
+	d.SetId(repoName)
+
+	// The custom domain and the other settings can only be set once the site exists.
+	if update.CNAME != nil || update.Public != nil || update.HTTPSEnforced != nil {
+		if err = updateRepositoryPages(ctx, client, owner, repoName, update, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceGithubRepositoryPagesRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryPagesRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	pages, _, err := client.Repositories.GetPagesInfo(ctx, owner, repoName)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			log.Printf("[INFO] Removing GitHub Pages of repository %s/%s from state because it no longer exists in GitHub", owner, repoName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	var source []map[string]any
+	if pages.GetBuildType() == "legacy" && pages.Source != nil {
+		source = []map[string]any{{
+			"branch": pages.GetSource().GetBranch(),
+			"path":   pages.GetSource().GetPath(),
+		}}
+	}
+
+	if err = d.Set("build_type", pages.GetBuildType()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("source", source); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("cname", pages.GetCNAME()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("https_enforced", pages.GetHTTPSEnforced()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("public", pages.GetPublic()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("url", pages.GetURL()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("html_url", pages.GetHTMLURL()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("status", pages.GetStatus()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("custom_404", pages.GetCustom404()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("https_certificate_state", pages.GetHTTPSCertificate().GetState()); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryPagesUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	if err := updateRepositoryPages(ctx, client, owner, repoName, expandRepositoryPagesUpdate(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGithubRepositoryPagesRead(ctx, d, meta)
+}
+
+func resourceGithubRepositoryPagesDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	client := meta.(*Owner).v3client
+	owner := meta.(*Owner).name
+	repoName := d.Get("repository").(string)
+
+	_, err := client.Repositories.DisablePages(ctx, owner, repoName)
+	if err != nil {
+		var ghErr *github.ErrorResponse
+		if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return diag.FromErr(handleArchivedRepoDelete(err, "GitHub Pages", repoName, owner, repoName))
+	}
+
+	return nil
+}
+
+func resourceGithubRepositoryPagesImport(_ context.Context, d *schema.ResourceData, _ any) ([]*schema.ResourceData, error) {
+	if err := d.Set("repository", d.Id()); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceGithubRepositoryPagesDiff(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Get("build_type").(string) == "legacy" && d.NewValueKnown("source") && len(d.Get("source").([]any)) == 0 {
+		return fmt.Errorf("source is required when build_type is legacy")
+	}
+	return nil
+}
+
+func expandRepositoryPagesUpdate(d *schema.ResourceData) *github.PagesUpdate {
+	update := &github.PagesUpdate{
+		BuildType: new(d.Get("build_type").(string)),
+	}
+
+	// An empty custom domain removes it, so it's only sent when set or removed.
+	if v := d.Get("cname").(string); v != "" || d.HasChange("cname") {
+		update.CNAME = new(v)
+	}
+	if v := d.Get("source").([]any); *update.BuildType == "legacy" && len(v) > 0 && v[0] != nil {
+		source := v[0].(map[string]any)
+		update.Source = &github.PagesSource{
+			Branch: new(source["branch"].(string)),
+			Path:   new(source["path"].(string)),
+		}
+	}
+	if v, ok := d.GetOkExists("public"); ok { //nolint:staticcheck // SA1019 // We sometimes need to use GetOkExists for booleans
+		update.Public = new(v.(bool))
+	}
+	if v, ok := d.GetOkExists("https_enforced"); ok { //nolint:staticcheck // SA1019 // We sometimes need to use GetOkExists for booleans
+		update.HTTPSEnforced = new(v.(bool))
+	}
+
+	return update
+}
+
+// updateRepositoryPages updates the site. HTTPS can only be enforced once the certificate of the custom domain is
+// issued, which happens asynchronously after the custom domain is set, so enforcing it is retried until the timeout.
+func updateRepositoryPages(ctx context.Context, client *github.Client, owner, repoName string, update *github.PagesUpdate, timeout time.Duration) error {
+	httpsEnforced := update.HTTPSEnforced
+	update.HTTPSEnforced = nil
+	if _, err := client.Repositories.UpdatePages(ctx, owner, repoName, update); err != nil {
+		return fmt.Errorf("error updating GitHub Pages of repository %s/%s: %w", owner, repoName, err)
+	}
+	if httpsEnforced == nil {
+		return nil
+	}
+
+	update.HTTPSEnforced = httpsEnforced
+	err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+		_, err := client.Repositories.UpdatePages(ctx, owner, repoName, update)
+		if err == nil {
+			return nil
+		}
+		var ghErr *github.ErrorResponse
+		if *httpsEnforced && errors.As(err, &ghErr) && strings.Contains(strings.ToLower(ghErr.Message), "certificate") {
+			log.Printf("[DEBUG] Waiting for the certificate of GitHub Pages of repository %s/%s to enforce HTTPS: %s", owner, repoName, ghErr.Message)
+			return retry.RetryableError(err)
+		}
+		return retry.NonRetryableError(err)
+	})
+	if err != nil {
+		return fmt.Errorf("error enforcing HTTPS for GitHub Pages of repository %s/%s: %w", owner, repoName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestGithubRepositoryPagesCreate(t *testing.T) {
+	var mu sync.Mutex
+	var created map[string]any
+	var updates []map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /repos/test-owner/repo/pages", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			t.Error(err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		mustWrite(w, `{}`)
+	})
+	mux.HandleFunc("PUT /repos/test-owner/repo/pages", func(w http.ResponseWriter, r *http.Request) {
+		var update map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			t.Error(err)
+		}
+		mu.Lock()
+		updates = append(updates, update)
+		attempts := len(updates)
+		mu.Unlock()
+
+		// The certificate is issued after the custom domain is set.
+		if _, ok := update["https_enforced"]; ok && attempts < 3 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			mustWrite(w, `{"message": "The certificate does not exist yet"}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("GET /repos/test-owner/repo/pages", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+			"url": "https://api.github.com/repos/test-owner/repo/pages",
+			"html_url": "https://docs.example.com/",
+			"status": "built",
+			"cname": "docs.example.com",
+			"build_type": "legacy",
+			"source": {"branch": "main", "path": "/docs"},
+			"public": true,
+			"https_enforced": true,
+			"https_certificate": {"state": "approved"}
+		}`)
+	})
+
+	meta := newTestOwner(t, mux, "test-owner", false)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepositoryPages().Schema, map[string]any{
+		"repository": "repo",
+		"build_type": "legacy",
+		"source": []any{
+			map[string]any{"branch": "main", "path": "/docs"},
+		},
+		"cname":          "docs.example.com",
+		"https_enforced": true,
+	})
+
+	if diags := resourceGithubRepositoryPagesCreate(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := fmt.Sprint(created); got != "map[build_type:legacy source:map[branch:main path:/docs]]" {
+		t.Errorf("got create request %s", got)
+	}
+	if len(updates) != 3 {
+		t.Fatalf("got %d update requests; want 3", len(updates))
+	}
+	if _, ok := updates[0]["https_enforced"]; ok || updates[0]["cname"] != "docs.example.com" {
+		t.Errorf("got first update request %v; want the custom domain without HTTPS enforcement", updates[0])
+	}
+	if updates[2]["https_enforced"] != true {
+		t.Errorf("got last update request %v; want HTTPS enforcement", updates[2])
+	}
+
+	for k, want := range map[string]any{
+		"https_enforced":          true,
+		"https_certificate_state": "approved",
+		"source.0.path":           "/docs",
+		"html_url":                "https://docs.example.com/",
+	} {
+		if got := d.Get(k); got != want {
+			t.Errorf("got %s %v; want %v", k, got, want)
+		}
+	}
+}
+
+func TestExpandRepositoryPagesUpdate(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "repo",
+		Attributes: map[string]string{
+			"id":         "repo",
+			"repository": "repo",
+			"build_type": "workflow",
+			"cname":      "docs.example.com",
+		},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		cname string
+	}{
+		{name: "sends the custom domain", cname: "docs.example.com"},
+		{name: "sends an empty custom domain to remove it", cname: ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config := map[string]any{"repository": "repo", "build_type": "workflow"}
+			if tc.cname != "" {
+				config["cname"] = tc.cname
+			}
+
+			r := resourceGithubRepositoryPages()
+			diff, err := r.Diff(t.Context(), state, terraform.NewResourceConfigRaw(config), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := schema.InternalMap(r.Schema).Data(state, diff)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := expandRepositoryPagesUpdate(d).CNAME; got == nil || *got != tc.cname {
+				t.Errorf("got CNAME %v; want %q", got, tc.cname)
+			}
+		})
+	}
+
+	t.Run("leaves an unset custom domain alone", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, resourceGithubRepositoryPages().Schema, map[string]any{"repository": "repo", "build_type": "workflow"})
+		if got := expandRepositoryPagesUpdate(d).CNAME; got != nil {
+			t.Errorf("got CNAME %q; want none", *got)
+		}
+	})
+}
+
+func TestAccGithubRepositoryPages(t *testing.T) {
+	t.Run("manages the GitHub Pages site of a repository", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		config := `
+			resource "github_repository" "test" {
+				name       = "%[1]spages-%[2]s"
+				visibility = "public"
+				auto_init  = true
+			}
+
+			resource "github_repository_pages" "test" {
+				repository = github_repository.test.name
+				build_type = "%[3]s"
+
+				dynamic "source" {
+					for_each = "%[3]s" == "legacy" ? [1] : []
+					content {
+						branch = "main"
+					}
+				}
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck:          func() { skipUnauthenticated(t) },
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "legacy"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_pages.test", "build_type", "legacy"),
+						resource.TestCheckResourceAttr("github_repository_pages.test", "source.0.branch", "main"),
+						resource.TestCheckResourceAttrSet("github_repository_pages.test", "html_url"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, testResourcePrefix, randomID, "workflow"),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_pages.test", "build_type", "workflow"),
+						resource.TestCheckResourceAttr("github_repository_pages.test", "source.#", "0"),
+					),
+				},
+				{
+					ResourceName:      "github_repository_pages.test",
+					ImportState:       true,
+					ImportStateVerify: true,
+				},
+			},
+		})
+	})
+}
@@ -111,6 +111,9 @@ func resourceGithubRepositoryWebhookCreate(ctx context.Context, d *schema.Resour
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 	hk := resourceGithubRepositoryWebhookObject(d)
+	if err := expandWebhookWriteOnlySecret(d, hk); err != nil {
+		return diag.FromErr(err)
+	}
 
 	hook, _, err := client.Repositories.CreateHook(ctx, owner, repoName, hk)
 	if err != nil {
@@ -118,14 +121,7 @@ func resourceGithubRepositoryWebhookCreate(ctx context.Context, d *schema.Resour
 	}
 	d.SetId(strconv.FormatInt(hook.GetID(), 10))
 
-	// GitHub returns the secret as a string of 8 astrisks "********"
-	// We would prefer to store the real secret in state, so we'll
-	// write the configuration secret in state from our request to GitHub
-	if hook.Config.Secret != nil {
-		hook.Config.Secret = hk.Config.Secret
-	}
-
-	if err = d.Set("configuration", interfaceFromWebhookConfig(hook.Config)); err != nil {
+	if err = d.Set("configuration", flattenWebhookConfiguration(d, hook.Config)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -172,19 +168,7 @@ func resourceGithubRepositoryWebhookRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	// GitHub returns the secret as a string of 8 astrisks "********"
-	// We would prefer to store the real secret in state, so we'll
-	// write the configuration secret in state from what we get from
-	// ResourceData
-	if len(d.Get("configuration").([]any)) > 0 {
-		currentSecret := d.Get("configuration").([]any)[0].(map[string]any)["secret"]
-
-		if hook.Config.Secret != nil {
-			hook.Config.Secret = new(currentSecret.(string))
-		}
-	}
-
-	if err = d.Set("configuration", interfaceFromWebhookConfig(hook.Config)); err != nil {
+	if err = d.Set("configuration", flattenWebhookConfiguration(d, hook.Config)); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -197,6 +181,9 @@ func resourceGithubRepositoryWebhookUpdate(ctx context.Context, d *schema.Resour
 	owner := meta.(*Owner).name
 	repoName := d.Get("repository").(string)
 	hk := resourceGithubRepositoryWebhookObject(d)
+	if err := expandWebhookWriteOnlySecret(d, hk); err != nil {
+		return diag.FromErr(err)
+	}
 	hookID, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return diag.FromErr(unconvertibleIdErr(d.Id(), err))
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccGithubRepositoryWebhook(t *testing.T) {
@@ -163,4 +164,54 @@ func TestAccGithubRepositoryWebhook(t *testing.T) {
 			},
 		})
 	})
+
+	t.Run("updates repository webhook write-only secrets without error", func(t *testing.T) {
+		randomID := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+		repoName := fmt.Sprintf("%srepo-webhook-%s", testResourcePrefix, randomID)
+		config := `
+			resource "github_repository" "test" {
+			  name         = "%[1]s"
+			  description  = "Terraform acceptance tests"
+			}
+
+			resource "github_repository_webhook" "test" {
+			  repository = github_repository.test.name
+
+			  configuration {
+			    secret_wo         = "%[2]s"
+			    secret_wo_version = %[3]d
+			    url               = "https://google.de/webhook"
+			    content_type      = "json"
+			    insecure_ssl      = true
+			  }
+
+			  events = ["pull_request"]
+			}
+		`
+
+		resource.Test(t, resource.TestCase{
+			PreCheck: func() { skipUnauthenticated(t) },
+			TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+				tfversion.SkipBelow(tfversion.Version1_11_0),
+			},
+			ProviderFactories: providerFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(config, repoName, "secret", 1),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_webhook.test", "configuration.0.secret", ""),
+						resource.TestCheckNoResourceAttr("github_repository_webhook.test", "configuration.0.secret_wo"),
+						resource.TestCheckResourceAttr("github_repository_webhook.test", "configuration.0.secret_wo_version", "1"),
+					),
+				},
+				{
+					Config: fmt.Sprintf(config, repoName, "updated-secret", 2),
+					Check: resource.ComposeTestCheckFunc(
+						resource.TestCheckResourceAttr("github_repository_webhook.test", "configuration.0.secret", ""),
+						resource.TestCheckResourceAttr("github_repository_webhook.test", "configuration.0.secret_wo_version", "2"),
+					),
+				},
+			},
+		})
+	})
 }
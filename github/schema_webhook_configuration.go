@@ -1,6 +1,8 @@
 package github
 
 import (
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -24,10 +26,25 @@ func webhookConfigurationSchema() *schema.Schema {
 					Description: "The content type for the payload. Valid values are either 'form' or 'json'.",
 				},
 				"secret": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Sensitive:   true,
-					Description: "The shared secret for the webhook",
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"configuration.0.secret_wo"},
+					Description:   "The shared secret for the webhook",
+				},
+				"secret_wo": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					WriteOnly:     true,
+					ConflictsWith: []string{"configuration.0.secret"},
+					Description:   "The shared secret for the webhook. This value is write-only and is never stored in the plan or state.",
+				},
+				"secret_wo_version": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					RequiredWith: []string{"configuration.0.secret_wo"},
+					Description:  "Version of the write-only secret. Changing it triggers an update of the webhook with the current secret_wo.",
 				},
 				"insecure_ssl": {
 					Type:        schema.TypeBool,
@@ -38,3 +55,43 @@ func webhookConfigurationSchema() *schema.Schema {
 		},
 	}
 }
+
+// expandWebhookWriteOnlySecret sets the webhook secret from the write-only configuration.0.secret_wo attribute, if set.
+func expandWebhookWriteOnlySecret(d *schema.ResourceData, hook *github.Hook) error {
+	if hook.Config == nil {
+		return nil
+	}
+
+	secret, err := getWriteOnlyString(d, cty.GetAttrPath("configuration").IndexInt(0).GetAttr("secret_wo"))
+	if err != nil {
+		return err
+	}
+
+	if len(secret) > 0 {
+		hook.Config.Secret = new(secret)
+	}
+
+	return nil
+}
+
+// flattenWebhookConfiguration converts a webhook configuration returned by GitHub into its schema representation.
+// GitHub returns the secret as a string of 8 asterisks "********" and knows nothing about the write-only secret
+// version, so both are written to state from what we get from ResourceData.
+func flattenWebhookConfiguration(d *schema.ResourceData, config *github.HookConfig) []any {
+	var secretVersion any
+	if current := d.Get("configuration").([]any); len(current) > 0 && current[0] != nil {
+		currentConfig := current[0].(map[string]any)
+
+		if config.Secret != nil {
+			config.Secret = new(currentConfig["secret"].(string))
+		}
+		secretVersion = currentConfig["secret_wo_version"]
+	}
+
+	flattened := interfaceFromWebhookConfig(config)
+	if secretVersion != nil {
+		flattened[0].(map[string]any)["secret_wo_version"] = secretVersion
+	}
+
+	return flattened
+}
@@ -3,10 +3,14 @@ package github
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,8 +18,9 @@ import (
 )
 
 const (
-	ctxEtag = ctxEtagType("etag")
-	ctxId   = ctxIdType("id")
+	ctxEtag      = ctxEtagType("etag")
+	ctxId        = ctxIdType("id")
+	ctxSkipCache = ctxSkipCacheType("skip-cache")
 )
 
 // ctxIdType is used to avoid collisions between packages using context.
@@ -24,6 +29,9 @@ type ctxIdType string
 // ctxEtagType is used to avoid collisions between packages using context.
 type ctxEtagType string
 
+// ctxSkipCacheType is used to avoid collisions between packages using context.
+type ctxSkipCacheType string
+
 // etagTransport allows saving API quota by passing previously stored Etag
 // available via context to request headers.
 type etagTransport struct {
@@ -54,11 +62,21 @@ type RateLimitTransport struct {
 	writeDelay       time.Duration
 	readDelay        time.Duration
 	parallelRequests bool
+	rateLimits       *rateLimitBudgets
 
 	m sync.Mutex
 }
 
 func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// When the rate limit budget of the request is running low, wait to spread the remaining requests over the
+	// time left until the budget resets. This happens before taking the lock, so that waiting for one bucket,
+	// possibly until it resets, doesn't block the requests to the others.
+	resource := rateLimitResource(req)
+	if pace := rlt.rateLimits.pace(resource, time.Now()); pace > 0 {
+		log.Printf("[DEBUG] Pacing %s requests by %s to spread the remaining rate limit until it resets", resource, pace)
+		sleep(req.Context(), pace)
+	}
+
 	// Make requests for a single user or client ID serially when parallel_requests is false.
 	// If parallel_requests is true skips the lock and allow the parallelism defined by terraform itself.
 	rlt.smartLock(true)
@@ -78,6 +96,8 @@ func (rlt *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, err
 		return resp, err
 	}
 
+	rlt.rateLimits.update(resource, resp.Header)
+
 	// Make response body accessible for retries & debugging
 	// (work around bug in GitHub SDK)
 	// See https://github.com/google/go-github/pull/986
@@ -159,7 +179,8 @@ type RateLimitTransportOption func(*RateLimitTransport)
 func NewRateLimitTransport(rt http.RoundTripper, options ...RateLimitTransportOption) *RateLimitTransport {
 	// Default to 1 second of write delay if none is provided
 	// Default to no read delay if none is provided
-	rlt := &RateLimitTransport{transport: rt, writeDelay: 1 * time.Second, readDelay: 0 * time.Second, parallelRequests: false}
+	// Default to tracking rate limits without a reserve if no budgets are provided
+	rlt := &RateLimitTransport{transport: rt, writeDelay: 1 * time.Second, readDelay: 0 * time.Second, parallelRequests: false, rateLimits: newRateLimitBudgets(0, false)}
 
 	for _, opt := range options {
 		opt(rlt)
@@ -189,6 +210,149 @@ func WithParallelRequests(p bool) RateLimitTransportOption {
 	}
 }
 
+// WithRateLimitBudgets is used to share the tracked rate limit budgets, and their reserve, between transports.
+func WithRateLimitBudgets(b *rateLimitBudgets) RateLimitTransportOption {
+	return func(rlt *RateLimitTransport) {
+		rlt.rateLimits = b
+	}
+}
+
+// rateLimitPacingThreshold is the share of the rate limit, excluding the reserve, below which requests are paced.
+const rateLimitPacingThreshold = 0.5
+
+// rateLimitBudget is the state of a rate limit bucket as reported by the X-RateLimit response headers.
+type rateLimitBudget struct {
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// rateLimitBudgets tracks the primary rate limit budget of each resource bucket (core, graphql, search, ...)
+// from the X-RateLimit response headers. The reserve is a number of requests per bucket left for other automation
+// sharing the token: once it's reached, requests wait until the bucket resets. With pacing, requests are also
+// spread over the time left until the bucket resets, rather than exhausting the budget and stalling.
+// https://docs.github.com/en/rest/using-the-rest-api/rate-limits-for-the-rest-api#checking-the-status-of-your-rate-limit
+type rateLimitBudgets struct {
+	reserve int
+	pacing  bool
+
+	mu      sync.Mutex
+	buckets map[string]rateLimitBudget
+	// next is the earliest time the next paced request to a bucket can be sent, so requests sent in parallel are
+	// spread too rather than each waiting for the same delay.
+	next   map[string]time.Time
+	warned map[string]time.Time
+	// pending are the warnings about reaching the reserve which haven't been reported as diagnostics yet.
+	pending []string
+}
+
+func newRateLimitBudgets(reserve int, pacing bool) *rateLimitBudgets {
+	return &rateLimitBudgets{
+		reserve: reserve,
+		pacing:  pacing,
+		buckets: make(map[string]rateLimitBudget),
+		next:    make(map[string]time.Time),
+		warned:  make(map[string]time.Time),
+	}
+}
+
+// rateLimitResource returns the rate limit bucket a request is expected to be counted against. The bucket of
+// a response is reported by the X-RateLimit-Resource header, which takes precedence when recording budgets.
+func rateLimitResource(req *http.Request) string {
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/graphql"):
+		return "graphql"
+	case strings.Contains(req.URL.Path, "/search/"):
+		return "search"
+	default:
+		return "core"
+	}
+}
+
+// update records the budget reported by the rate limit headers of a response.
+func (b *rateLimitBudgets) update(resource string, header http.Header) {
+	if b == nil {
+		return
+	}
+
+	limit, err := strconv.Atoi(header.Get(github.HeaderRateLimit))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(header.Get(github.HeaderRateRemaining))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(header.Get(github.HeaderRateReset), 10, 64)
+	if err != nil {
+		return
+	}
+	if r := header.Get(github.HeaderRateResource); r != "" {
+		resource = r
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.buckets[resource] = rateLimitBudget{limit: limit, remaining: remaining, reset: time.Unix(reset, 0)}
+}
+
+// pace returns the delay needed before the next request to a resource bucket. Once only the reserve is left, the
+// request waits until the bucket resets. With pacing, and once less than rateLimitPacingThreshold of the budget above
+// the reserve remains, the requests are given consecutive slots spreading that budget until the bucket resets.
+func (b *rateLimitBudgets) pace(resource string, now time.Time) time.Duration {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	budget, ok := b.buckets[resource]
+	untilReset := budget.reset.Sub(now)
+	if !ok || budget.limit <= b.reserve || untilReset <= 0 {
+		return 0
+	}
+
+	available := budget.remaining - b.reserve
+	if b.reserve > 0 && available <= 0 {
+		if !b.warned[resource].Equal(budget.reset) {
+			warning := fmt.Sprintf("Only %d of %d requests remain in the GitHub %s rate limit, which is the configured rate_limit_reserve of %d. "+
+				"Waiting until it resets at %s.", budget.remaining, budget.limit, resource, b.reserve, budget.reset.Format(time.RFC3339))
+			log.Printf("[WARN] %s", warning)
+			b.pending = append(b.pending, warning)
+			b.warned[resource] = budget.reset
+		}
+		return untilReset
+	}
+	if !b.pacing || available <= 0 || available > int(float64(budget.limit-b.reserve)*rateLimitPacingThreshold) {
+		// An exhausted budget without a reserve is left to the RateLimitError, which sleeps until the bucket resets.
+		return 0
+	}
+
+	slot := now
+	if next := b.next[resource]; next.After(slot) {
+		slot = next
+	}
+	b.next[resource] = slot.Add(untilReset / time.Duration(available))
+
+	return slot.Sub(now)
+}
+
+// warnings returns the warnings about reaching the reserve recorded since the last call.
+func (b *rateLimitBudgets) warnings() []string {
+	if b == nil {
+		return nil
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	warnings := b.pending
+	b.pending = nil
+	return warnings
+}
+
 // drainBody reads all of b to memory and then returns two equivalent
 // ReadClosers yielding the same bytes.
 func drainBody(b io.ReadCloser) (r1, r2 io.ReadCloser, err error) {
@@ -309,3 +473,268 @@ func WithRetryDelay(d time.Duration) RetryTransportOption {
 		rt.retryDelay = d
 	}
 }
+
+// maxCachedResponseSize is the size above which response bodies are not cached, such as release asset downloads.
+const maxCachedResponseSize = 1 << 20
+
+// responseCacheTTL is how long reads are cached. It's long enough to serve the lookups repeated by the resources of a
+// single refresh, but short enough for loops polling for a state change to see it without opting out of the cache.
+const responseCacheTTL = 5 * time.Second
+
+// responseCache is a provider-scoped cache of successful GitHub API reads, so lookups repeated by many resources,
+// such as the ID of a team or a repository, are only requested once. Identical reads in flight at the same time
+// are coalesced into a single request.
+//
+// Writes invalidate the cached reads they may affect. As the same state is often served by several paths (a
+// branch by /git/ref and /branches, a team by its ID and its slug), this is done per scope rather than per path:
+// writes to a repository invalidate the reads of that repository and all reads outside of repositories, including
+// GraphQL ones, while any other write invalidates the whole cache. Reads also expire after the TTL of the cache, as
+// GitHub changes some state on its own, such as when a build completes.
+type responseCache struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	// generation is incremented by every write, so reads which were in flight during a write are not cached.
+	generation uint64
+	entries    map[string]*cachedResponse
+	inFlight   map[string]*cacheCall
+}
+
+// cachedResponse is a response stored by the responseCache. The scope is the repository path of the response, or
+// empty for responses outside of repositories.
+type cachedResponse struct {
+	scope      string
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+	expires    time.Time
+}
+
+// cacheCall is a read in flight, which identical reads wait for instead of sending their own request.
+type cacheCall struct {
+	done     chan struct{}
+	response *cachedResponse
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{
+		ttl:      responseCacheTTL,
+		entries:  make(map[string]*cachedResponse),
+		inFlight: make(map[string]*cacheCall),
+	}
+}
+
+// lookup returns the cached response for key if there is one. Otherwise it returns the call in flight for key and
+// whether the caller is its leader, which must send the request and finish the call.
+func (c *responseCache) lookup(key string) (*cachedResponse, *cacheCall, bool, uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[key]; ok {
+		if time.Now().Before(entry.expires) {
+			return entry, nil, false, c.generation
+		}
+		delete(c.entries, key)
+	}
+	if call, ok := c.inFlight[key]; ok {
+		return nil, call, false, c.generation
+	}
+
+	call := &cacheCall{done: make(chan struct{})}
+	c.inFlight[key] = call
+	return nil, call, true, c.generation
+}
+
+// finish completes the call for key, caching its response unless a write happened since it was sent.
+func (c *responseCache) finish(key string, call *cacheCall, entry *cachedResponse, generation uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry != nil && generation == c.generation {
+		entry.expires = time.Now().Add(c.ttl)
+		c.entries[key] = entry
+		call.response = entry
+	}
+	delete(c.inFlight, key)
+	close(call.done)
+}
+
+// invalidate removes the cached reads affected by a write to a scope.
+func (c *responseCache) invalidate(scope string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	for key, entry := range c.entries {
+		if scope == "" || entry.scope == "" || entry.scope == scope {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// cacheScope returns the /repos/{owner}/{repo} prefix of a REST API path, or an empty string for other paths.
+func cacheScope(path string) string {
+	path = strings.TrimPrefix(path, "/api/v3")
+	parts := strings.SplitN(path, "/", 5)
+	if len(parts) < 4 || parts[0] != "" || parts[1] != "repos" {
+		return ""
+	}
+	return strings.ToLower(strings.Join(parts[:4], "/"))
+}
+
+// response returns a new response for req with the cached status, headers and body.
+func (r *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        r.status,
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// cacheTransport serves reads from a responseCache and invalidates it on writes.
+type cacheTransport struct {
+	transport http.RoundTripper
+	cache     *responseCache
+}
+
+// NewCacheTransport takes in an http.RoundTripper and the responseCache shared by the clients of a provider.
+func NewCacheTransport(rt http.RoundTripper, cache *responseCache) *cacheTransport {
+	return &cacheTransport{transport: rt, cache: cache}
+}
+
+func (ct *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, scope, write, err := cacheKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if write {
+		resp, err := ct.transport.RoundTrip(req)
+		ct.cache.invalidate(scope)
+		return resp, err
+	}
+	if key == "" {
+		return ct.transport.RoundTrip(req)
+	}
+
+	entry, call, leader, generation := ct.cache.lookup(key)
+	if entry != nil {
+		log.Printf("[DEBUG] Serving %s %s from the response cache", req.Method, req.URL)
+		return entry.response(req), nil
+	}
+
+	if !leader {
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if call.response != nil {
+			log.Printf("[DEBUG] Serving %s %s from a coalesced request", req.Method, req.URL)
+			return call.response.response(req), nil
+		}
+		// The request of the leader failed or its response is not cacheable, so send our own.
+		return ct.transport.RoundTrip(req)
+	}
+
+	resp, err := ct.transport.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusOK {
+		entry, err = newCachedResponse(scope, resp, req.Method == http.MethodPost)
+		if err != nil {
+			// A RoundTripper must not return a response together with an error.
+			resp.Body.Close()
+			ct.cache.finish(key, call, nil, generation)
+			return nil, err
+		}
+	}
+	ct.cache.finish(key, call, entry, generation)
+
+	return resp, err
+}
+
+// cacheKey returns the key a request is cached under, the scope it is cached in or invalidates, and whether it is
+// a write. The key is empty for reads that must not be cached, such as conditional requests relying on an ETag, whose
+// If-None-Match header is only set by the etagTransport further down the chain, or reads which opt out by setting
+// ctxSkipCache in the request context.
+func cacheKey(req *http.Request) (key, scope string, write bool, err error) {
+	switch req.Method {
+	case http.MethodGet:
+		if skip, _ := req.Context().Value(ctxSkipCache).(bool); skip {
+			return "", "", false, nil
+		}
+		if etag, _ := req.Context().Value(ctxEtag).(string); etag != "" {
+			return "", "", false, nil
+		}
+		if req.Header.Get("If-None-Match") != "" || req.Header.Get("Range") != "" {
+			return "", "", false, nil
+		}
+		return strings.Join([]string{req.Method, req.Header.Get("Accept"), req.URL.String()}, " "), cacheScope(req.URL.Path), false, nil
+	case http.MethodHead, http.MethodOptions:
+		return "", "", false, nil
+	}
+
+	if req.Method != http.MethodPost || !strings.HasSuffix(req.URL.Path, "/graphql") || req.Body == nil {
+		return "", cacheScope(req.URL.Path), true, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return "", "", false, err
+	}
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	var query struct {
+		Query string `json:"query"`
+	}
+	if err := json.Unmarshal(body, &query); err != nil || strings.HasPrefix(strings.TrimSpace(query.Query), "mutation") {
+		return "", "", true, nil
+	}
+
+	return strings.Join([]string{req.Method, req.URL.String(), string(body)}, " "), "", false, nil
+}
+
+// newCachedResponse reads the body of a successful response into a cachedResponse and replaces it with a copy. It
+// returns nil for responses that are too large to cache and for GraphQL responses reporting errors.
+func newCachedResponse(scope string, resp *http.Response, graphQL bool) (*cachedResponse, error) {
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxCachedResponseSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxCachedResponseSize {
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		return nil, nil
+	}
+
+	if err := resp.Body.Close(); err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if graphQL {
+		var graphQLResponse struct {
+			Errors json.RawMessage `json:"errors"`
+		}
+		if err := json.Unmarshal(body, &graphQLResponse); err != nil || len(graphQLResponse.Errors) > 0 {
+			return nil, nil
+		}
+	}
+
+	return &cachedResponse{
+		scope:      scope,
+		status:     resp.Status,
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	}, nil
+}
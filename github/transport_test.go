@@ -9,6 +9,9 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -381,6 +384,333 @@ func TestRateLimitTransport_smart_lock(t *testing.T) {
 	})
 }
 
+func TestRateLimitTransport_budgets(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Unix()
+	ts := githubApiMock([]*mockResponse{
+		{
+			ExpectedUri:  "/repos/test/blah",
+			ResponseBody: `{"id": 1234}`,
+			StatusCode:   200,
+			ResponseHeaders: map[string]string{
+				"X-RateLimit-Limit":     "5000",
+				"X-RateLimit-Remaining": "90",
+				"X-RateLimit-Reset":     strconv.FormatInt(reset, 10),
+				"X-RateLimit-Resource":  "core",
+			},
+		},
+	})
+	defer ts.Close()
+
+	budgets := newRateLimitBudgets(100, false)
+	httpClient := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, WithRateLimitBudgets(budgets))}
+
+	client := github.NewClient(httpClient)
+	u, _ := url.Parse(ts.URL + "/")
+	client.BaseURL = u
+
+	if _, _, err := client.Repositories.Get(t.Context(), "test", "blah"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := budgets.buckets["core"].remaining; got != 90 {
+		t.Errorf("Expected 90 remaining core requests to be tracked, got: %d", got)
+	}
+
+	if got := budgets.pace("core", time.Unix(reset, 0).Add(-time.Minute)); got != time.Minute {
+		t.Errorf("Expected to wait for the reset once the reserve is reached, got: %s", got)
+	}
+	budgets.pace("core", time.Unix(reset, 0).Add(-time.Minute))
+	if got := budgets.warnings(); len(got) != 1 {
+		t.Errorf("Expected a single warning about reaching the reserve, got: %v", got)
+	}
+	if got := budgets.warnings(); len(got) != 0 {
+		t.Errorf("Expected warnings to be reported once, got: %v", got)
+	}
+}
+
+func TestRateLimitTransport_waitsForResetWithoutLocking(t *testing.T) {
+	budgets := newRateLimitBudgets(100, false)
+	budgets.buckets["core"] = rateLimitBudget{limit: 5000, remaining: 50, reset: time.Now().Add(time.Hour)}
+	transport := NewRateLimitTransport(localRoundTripper{handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mustWrite(w, `{}`)
+	})}, WithRateLimitBudgets(budgets))
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	waiting := make(chan struct{})
+	go func() {
+		defer close(waiting)
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.github.com/repos/test/blah", nil)
+		if resp, err := transport.RoundTrip(req); err == nil {
+			resp.Body.Close()
+		}
+	}()
+
+	// The core request waits for its bucket to reset, which doesn't hold up GraphQL requests.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		req, _ := http.NewRequestWithContext(t.Context(), http.MethodPost, "https://api.github.com/graphql", strings.NewReader(`{}`))
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		resp.Body.Close()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected the GraphQL request not to wait for the core rate limit to reset")
+	}
+	cancel()
+	<-waiting
+}
+
+func TestRateLimitBudgets_pace(t *testing.T) {
+	now := time.Now()
+	reset := now.Add(100 * time.Second)
+
+	for _, tc := range []struct {
+		name      string
+		reserve   int
+		pacing    bool
+		remaining int
+		expected  time.Duration
+	}{
+		{name: "does not pace unless enabled", remaining: 1000, expected: 0},
+		{name: "does not pace while most of the budget remains", pacing: true, remaining: 4000, expected: 0},
+		{name: "waits for the reset once the reserve is reached", reserve: 500, remaining: 400, expected: 100 * time.Second},
+		{name: "waits for the reset once the reserve is reached while pacing", reserve: 500, pacing: true, remaining: 500, expected: 100 * time.Second},
+		{name: "leaves an exhausted budget to the rate limit error", pacing: true, remaining: 0, expected: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			budgets := newRateLimitBudgets(tc.reserve, tc.pacing)
+			budgets.buckets["core"] = rateLimitBudget{limit: 5000, remaining: tc.remaining, reset: reset}
+
+			if got := budgets.pace("core", now); got != tc.expected {
+				t.Errorf("Expected a delay of %s, got: %s", tc.expected, got)
+			}
+			if got := budgets.pace("graphql", now); got != 0 {
+				t.Errorf("Expected untracked buckets not to be paced, got: %s", got)
+			}
+		})
+	}
+
+	t.Run("gives consecutive requests consecutive slots", func(t *testing.T) {
+		budgets := newRateLimitBudgets(500, true)
+		budgets.buckets["core"] = rateLimitBudget{limit: 5000, remaining: 1000, reset: reset}
+
+		for i, expected := range []time.Duration{0, 200 * time.Millisecond, 400 * time.Millisecond} {
+			if got := budgets.pace("core", now); got != expected {
+				t.Errorf("Expected request %d to be delayed by %s, got: %s", i, expected, got)
+			}
+		}
+		if got := budgets.pace("core", now.Add(time.Second)); got != 0 {
+			t.Errorf("Expected a request after the reserved slots not to be delayed, got: %s", got)
+		}
+	})
+}
+
+func TestCacheTransport(t *testing.T) {
+	newClientWithCache := func(t *testing.T, delay time.Duration, cache *responseCache) (*http.Client, *httptest.Server, func(string) int) {
+		var mu sync.Mutex
+		requests := make(map[string]int)
+
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			requests[r.Method+" "+r.URL.Path]++
+			mu.Unlock()
+
+			time.Sleep(delay)
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path == "/graphql" {
+				body, _ := io.ReadAll(r.Body)
+				if strings.Contains(string(body), "missing") {
+					fmt.Fprint(w, `{"data": null, "errors": [{"type": "NOT_FOUND", "message": "Not Found"}]}`)
+					return
+				}
+				fmt.Fprint(w, `{"data": {"repository": {"id": "R_1"}}}`)
+				return
+			}
+			fmt.Fprint(w, `{"id": 1}`)
+		}))
+		t.Cleanup(ts.Close)
+
+		count := func(request string) int {
+			mu.Lock()
+			defer mu.Unlock()
+			return requests[request]
+		}
+
+		return &http.Client{Transport: NewCacheTransport(NewEtagTransport(http.DefaultTransport), cache)}, ts, count
+	}
+	newClient := func(t *testing.T, delay time.Duration) (*http.Client, *httptest.Server, func(string) int) {
+		return newClientWithCache(t, delay, newResponseCache())
+	}
+	do := func(t *testing.T, ctx context.Context, client *http.Client, method, url, body string) {
+		req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if b, _ := io.ReadAll(resp.Body); len(b) == 0 {
+			t.Fatalf("Expected a response body for %s %s", method, url)
+		}
+	}
+
+	t.Run("serves repeated reads from the cache", func(t *testing.T) {
+		client, ts, requests := newClient(t, 0)
+
+		for range 3 {
+			do(t, t.Context(), client, "GET", ts.URL+"/orgs/test/teams/blah", "")
+		}
+
+		if got := requests("GET /orgs/test/teams/blah"); got != 1 {
+			t.Fatalf("Expected 1 request, got: %d", got)
+		}
+	})
+
+	t.Run("coalesces identical reads in flight", func(t *testing.T) {
+		client, ts, requests := newClient(t, 100*time.Millisecond)
+
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Go(func() {
+				do(t, t.Context(), client, "GET", ts.URL+"/repos/test/blah", "")
+			})
+		}
+		wg.Wait()
+
+		if got := requests("GET /repos/test/blah"); got != 1 {
+			t.Fatalf("Expected 1 request, got: %d", got)
+		}
+	})
+
+	t.Run("invalidates reads of the repository written to", func(t *testing.T) {
+		client, ts, requests := newClient(t, 0)
+
+		do(t, t.Context(), client, "GET", ts.URL+"/repos/test/blah/git/ref/heads/main", "")
+		do(t, t.Context(), client, "GET", ts.URL+"/repos/test/other", "")
+		do(t, t.Context(), client, "PATCH", ts.URL+"/repos/test/blah/git/refs/heads/main", `{"sha": "abc"}`)
+		do(t, t.Context(), client, "GET", ts.URL+"/repos/test/blah/git/ref/heads/main", "")
+		do(t, t.Context(), client, "GET", ts.URL+"/repos/test/other", "")
+
+		if got := requests("GET /repos/test/blah/git/ref/heads/main"); got != 2 {
+			t.Fatalf("Expected the written repository to be read again, got %d requests", got)
+		}
+		if got := requests("GET /repos/test/other"); got != 1 {
+			t.Fatalf("Expected other repositories to stay cached, got %d requests", got)
+		}
+	})
+
+	t.Run("caches GraphQL queries until a mutation", func(t *testing.T) {
+		client, ts, requests := newClient(t, 0)
+		query := `{"query": "query($name:String!){repository(name:$name){id}}", "variables": {"name": "blah"}}`
+
+		do(t, t.Context(), client, "POST", ts.URL+"/graphql", query)
+		do(t, t.Context(), client, "POST", ts.URL+"/graphql", query)
+		if got := requests("POST /graphql"); got != 1 {
+			t.Fatalf("Expected the query to be cached, got %d requests", got)
+		}
+
+		do(t, t.Context(), client, "POST", ts.URL+"/graphql", `{"query": "mutation($input:DeleteBranchProtectionRuleInput!){deleteBranchProtectionRule(input:$input){clientMutationId}}"}`)
+		do(t, t.Context(), client, "POST", ts.URL+"/graphql", query)
+		if got := requests("POST /graphql"); got != 3 {
+			t.Fatalf("Expected the mutation to invalidate the query, got %d requests", got)
+		}
+	})
+
+	t.Run("does not cache GraphQL errors", func(t *testing.T) {
+		client, ts, requests := newClient(t, 0)
+		query := `{"query": "query($name:String!){repository(name:$name){id}}", "variables": {"name": "missing"}}`
+
+		do(t, t.Context(), client, "POST", ts.URL+"/graphql", query)
+		do(t, t.Context(), client, "POST", ts.URL+"/graphql", query)
+
+		if got := requests("POST /graphql"); got != 2 {
+			t.Fatalf("Expected 2 requests, got: %d", got)
+		}
+	})
+
+	t.Run("skips the cache when requested by the context", func(t *testing.T) {
+		client, ts, requests := newClient(t, 0)
+		ctx := context.WithValue(t.Context(), ctxSkipCache, true)
+
+		do(t, ctx, client, "GET", ts.URL+"/orgs/test/actions/hosted-runners/1", "")
+		do(t, ctx, client, "GET", ts.URL+"/orgs/test/actions/hosted-runners/1", "")
+
+		if got := requests("GET /orgs/test/actions/hosted-runners/1"); got != 2 {
+			t.Fatalf("Expected 2 requests, got: %d", got)
+		}
+	})
+	t.Run("does not cache conditional reads", func(t *testing.T) {
+		client, ts, requests := newClient(t, 0)
+		ctx := context.WithValue(t.Context(), ctxEtag, `"abc"`)
+
+		do(t, ctx, client, "GET", ts.URL+"/repos/test/blah", "")
+		do(t, ctx, client, "GET", ts.URL+"/repos/test/blah", "")
+
+		if got := requests("GET /repos/test/blah"); got != 2 {
+			t.Fatalf("Expected 2 requests, got: %d", got)
+		}
+	})
+
+	t.Run("expires reads after the TTL", func(t *testing.T) {
+		cache := newResponseCache()
+		cache.ttl = 50 * time.Millisecond
+		client, ts, requests := newClientWithCache(t, 0, cache)
+
+		do(t, t.Context(), client, "GET", ts.URL+"/orgs/test/teams/blah", "")
+		do(t, t.Context(), client, "GET", ts.URL+"/orgs/test/teams/blah", "")
+		time.Sleep(100 * time.Millisecond)
+		do(t, t.Context(), client, "GET", ts.URL+"/orgs/test/teams/blah", "")
+
+		if got := requests("GET /orgs/test/teams/blah"); got != 2 {
+			t.Fatalf("Expected 2 requests, got: %d", got)
+		}
+	})
+
+	t.Run("returns no response when reading the body fails", func(t *testing.T) {
+		body := &failingBody{}
+		client := &http.Client{Transport: NewCacheTransport(&mockRoundTripper{
+			roundTripFunc: func(req *http.Request) (*http.Response, error) {
+				return &http.Response{StatusCode: http.StatusOK, Status: "200 OK", Header: http.Header{}, Body: body}, nil
+			},
+		}, newResponseCache())}
+
+		resp, err := client.Transport.RoundTrip(httptest.NewRequest("GET", "https://api.github.com/orgs/test/teams/blah", nil))
+		if err == nil {
+			t.Fatal("Expected an error")
+		}
+		if resp != nil {
+			t.Errorf("Expected no response together with the error, got: %v", resp)
+		}
+		if !body.closed {
+			t.Error("Expected the response body to be closed")
+		}
+	})
+}
+
+// failingBody is a response body whose reads fail.
+type failingBody struct {
+	closed bool
+}
+
+func (b *failingBody) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func (b *failingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
 func TestRetryTransport_retry_post_error(t *testing.T) {
 	ts := githubApiMock([]*mockResponse{
 		{
@@ -330,3 +330,34 @@ func resourceKeysGetOk[T any](d *schema.ResourceData, keys ...string) (T, bool)
 	}
 	return empty, false
 }
+
+// getWriteOnlyString returns the value of a write-only string attribute. Write-only attributes are never persisted
+// to the plan or state, so they can only be read from the raw configuration during create and update.
+func getWriteOnlyString(d *schema.ResourceData, path cty.Path) (string, error) {
+	if d.GetRawConfig().IsNull() {
+		return "", nil
+	}
+
+	v, diags := d.GetRawConfigAt(path)
+	if diags.HasError() {
+		return "", fmt.Errorf("error reading write-only attribute: %s", diags[0].Summary)
+	}
+
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) {
+		return "", nil
+	}
+
+	return v.AsString(), nil
+}
+
+// getSecretPlaintextValue returns the plaintext value of a secret, preferring the write-only value_wo attribute
+// over the given attributes which are persisted to state.
+func getSecretPlaintextValue(d *schema.ResourceData, keys ...string) (string, error) {
+	value, err := getWriteOnlyString(d, cty.GetAttrPath("value_wo"))
+	if err != nil || len(value) > 0 {
+		return value, err
+	}
+
+	value, _ = resourceKeysGetOk[string](d, keys...)
+	return value, nil
+}
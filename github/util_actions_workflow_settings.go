@@ -0,0 +1,298 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The defaults GitHub uses for the workflow settings, which they are reset to when the resources are destroyed.
+const (
+	defaultArtifactAndLogRetentionDays = 90
+	defaultForkPRApprovalPolicy        = "first_time_contributors"
+)
+
+// actionsWorkflowSettingsAPI abstracts over the organization and repository endpoints of the Actions workflow settings,
+// which take the same payloads.
+type actionsWorkflowSettingsAPI struct {
+	getRetention      func(ctx context.Context) (*github.ArtifactPeriod, *github.Response, error)
+	updateRetention   func(ctx context.Context, period github.ArtifactPeriodOpt) (*github.Response, error)
+	getApproval       func(ctx context.Context) (*github.ContributorApprovalPermissions, *github.Response, error)
+	updateApproval    func(ctx context.Context, policy github.ContributorApprovalPermissions) (*github.Response, error)
+	getPrivateFork    func(ctx context.Context) (*github.WorkflowsPermissions, *github.Response, error)
+	updatePrivateFork func(ctx context.Context, permissions *github.WorkflowsPermissionsOpt) (*github.Response, error)
+}
+
+func organizationActionsWorkflowSettingsAPI(client *github.Client, org string) actionsWorkflowSettingsAPI {
+	return actionsWorkflowSettingsAPI{
+		getRetention: func(ctx context.Context) (*github.ArtifactPeriod, *github.Response, error) {
+			return client.Actions.GetArtifactAndLogRetentionPeriodInOrganization(ctx, org)
+		},
+		updateRetention: func(ctx context.Context, period github.ArtifactPeriodOpt) (*github.Response, error) {
+			return client.Actions.UpdateArtifactAndLogRetentionPeriodInOrganization(ctx, org, period)
+		},
+		getApproval: func(ctx context.Context) (*github.ContributorApprovalPermissions, *github.Response, error) {
+			return client.Actions.GetOrganizationForkPRContributorApprovalPermissions(ctx, org)
+		},
+		updateApproval: func(ctx context.Context, policy github.ContributorApprovalPermissions) (*github.Response, error) {
+			return client.Actions.UpdateOrganizationForkPRContributorApprovalPermissions(ctx, org, policy)
+		},
+		getPrivateFork: func(ctx context.Context) (*github.WorkflowsPermissions, *github.Response, error) {
+			return client.Actions.GetPrivateRepoForkPRWorkflowSettingsInOrganization(ctx, org)
+		},
+		updatePrivateFork: func(ctx context.Context, permissions *github.WorkflowsPermissionsOpt) (*github.Response, error) {
+			return client.Actions.UpdatePrivateRepoForkPRWorkflowSettingsInOrganization(ctx, org, permissions)
+		},
+	}
+}
+
+func repositoryActionsWorkflowSettingsAPI(client *github.Client, owner, repo string) actionsWorkflowSettingsAPI {
+	return actionsWorkflowSettingsAPI{
+		getRetention: func(ctx context.Context) (*github.ArtifactPeriod, *github.Response, error) {
+			return client.Repositories.GetArtifactAndLogRetentionPeriod(ctx, owner, repo)
+		},
+		updateRetention: func(ctx context.Context, period github.ArtifactPeriodOpt) (*github.Response, error) {
+			return client.Repositories.UpdateArtifactAndLogRetentionPeriod(ctx, owner, repo, period)
+		},
+		getApproval: func(ctx context.Context) (*github.ContributorApprovalPermissions, *github.Response, error) {
+			return client.Actions.GetForkPRContributorApprovalPermissions(ctx, owner, repo)
+		},
+		updateApproval: func(ctx context.Context, policy github.ContributorApprovalPermissions) (*github.Response, error) {
+			return client.Actions.UpdateForkPRContributorApprovalPermissions(ctx, owner, repo, policy)
+		},
+		getPrivateFork: func(ctx context.Context) (*github.WorkflowsPermissions, *github.Response, error) {
+			return client.Repositories.GetPrivateRepoForkPRWorkflowSettings(ctx, owner, repo)
+		},
+		updatePrivateFork: func(ctx context.Context, permissions *github.WorkflowsPermissionsOpt) (*github.Response, error) {
+			return client.Repositories.UpdatePrivateRepoForkPRWorkflowSettings(ctx, owner, repo, permissions)
+		},
+	}
+}
+
+// actionsWorkflowSettingsSchema returns the schema of the settings shared by the organization and repository resources.
+func actionsWorkflowSettingsSchema(level string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"artifact_and_log_retention_days": {
+			Type:             schema.TypeInt,
+			Optional:         true,
+			Computed:         true,
+			Description:      fmt.Sprintf("The number of days artifacts and logs of workflow runs in the %s are retained for.", level),
+			ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, 400)),
+		},
+		"maximum_artifact_and_log_retention_days": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("The maximum number of days artifacts and logs of workflow runs in the %s can be retained for.", level),
+		},
+		"fork_pr_approval_policy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: fmt.Sprintf("Which outside contributors need approval to run workflows on pull requests from forks of public repositories in the %s. Can be one of: 'first_time_contributors_new_to_github', 'first_time_contributors' or 'all_external_contributors'.", level),
+			ValidateDiagFunc: validateValueFunc([]string{
+				"first_time_contributors_new_to_github",
+				"first_time_contributors",
+				"all_external_contributors",
+			}),
+		},
+		"private_fork_pr_workflows": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: fmt.Sprintf("How workflows run on pull requests from forks of private repositories in the %s.", level),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"run_workflows": {
+						Type:        schema.TypeBool,
+						Required:    true,
+						Description: "Whether workflows run on pull requests from forks.",
+					},
+					"send_write_tokens": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether workflows on pull requests from forks are given a GITHUB_TOKEN with write permissions.",
+					},
+					"send_secrets_and_variables": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether secrets and variables are made available to workflows on pull requests from forks.",
+					},
+					"require_approval": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether workflows on pull requests from forks need approval from a user with write access to run.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// isActionsWorkflowSettingUnavailable reports whether a workflow setting doesn't apply to the organization or
+// repository, such as the private fork settings of a public repository.
+func isActionsWorkflowSettingUnavailable(err error) bool {
+	var ghErr *github.ErrorResponse
+	if !errors.As(err, &ghErr) {
+		return false
+	}
+	switch ghErr.Response.StatusCode {
+	case http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity:
+		return true
+	}
+	return false
+}
+
+// updateActionsWorkflowSettings updates the configured settings on create, and the changed ones on update.
+func updateActionsWorkflowSettings(ctx context.Context, d *schema.ResourceData, api actionsWorkflowSettingsAPI, update bool) error {
+	configured := func(key string) bool {
+		if update {
+			return d.HasChange(key)
+		}
+		_, ok := d.GetOk(key)
+		return ok
+	}
+
+	if configured("artifact_and_log_retention_days") {
+		days := d.Get("artifact_and_log_retention_days").(int)
+		if _, err := api.updateRetention(ctx, github.ArtifactPeriodOpt{Days: new(days)}); err != nil {
+			return fmt.Errorf("error updating artifact and log retention: %w", err)
+		}
+	}
+
+	if configured("fork_pr_approval_policy") {
+		policy := d.Get("fork_pr_approval_policy").(string)
+		if _, err := api.updateApproval(ctx, github.ContributorApprovalPermissions{ApprovalPolicy: policy}); err != nil {
+			return fmt.Errorf("error updating fork pull request approval policy: %w", err)
+		}
+	}
+
+	if configured("private_fork_pr_workflows") {
+		if v := d.Get("private_fork_pr_workflows").([]any); len(v) > 0 && v[0] != nil {
+			settings := v[0].(map[string]any)
+			permissions := &github.WorkflowsPermissionsOpt{
+				RunWorkflowsFromForkPullRequests:  settings["run_workflows"].(bool),
+				SendWriteTokensToWorkflows:        new(settings["send_write_tokens"].(bool)),
+				SendSecretsAndVariables:           new(settings["send_secrets_and_variables"].(bool)),
+				RequireApprovalForForkPRWorkflows: new(settings["require_approval"].(bool)),
+			}
+			if _, err := api.updatePrivateFork(ctx, permissions); err != nil {
+				return fmt.Errorf("error updating private fork pull request workflow settings: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// readActionsWorkflowSettings sets the settings in the state. Settings which don't apply are left empty.
+func readActionsWorkflowSettings(ctx context.Context, d *schema.ResourceData, api actionsWorkflowSettingsAPI) error {
+	retention, _, err := api.getRetention(ctx)
+	if err != nil {
+		return err
+	}
+	if err = d.Set("artifact_and_log_retention_days", retention.GetDays()); err != nil {
+		return err
+	}
+	if err = d.Set("maximum_artifact_and_log_retention_days", retention.GetMaximumAllowedDays()); err != nil {
+		return err
+	}
+
+	var policy string
+	approval, _, err := api.getApproval(ctx)
+	switch {
+	case err == nil:
+		policy = approval.ApprovalPolicy
+	case isActionsWorkflowSettingUnavailable(err):
+		log.Printf("[DEBUG] Fork pull request approval policy is unavailable: %s", err)
+	default:
+		return err
+	}
+	if err = d.Set("fork_pr_approval_policy", policy); err != nil {
+		return err
+	}
+
+	var privateFork []map[string]any
+	permissions, _, err := api.getPrivateFork(ctx)
+	switch {
+	case err == nil:
+		privateFork = []map[string]any{{
+			"run_workflows":              permissions.GetRunWorkflowsFromForkPullRequests(),
+			"send_write_tokens":          permissions.GetSendWriteTokensToWorkflows(),
+			"send_secrets_and_variables": permissions.GetSendSecretsAndVariables(),
+			"require_approval":           permissions.GetRequireApprovalForForkPRWorkflows(),
+		}}
+	case isActionsWorkflowSettingUnavailable(err):
+		log.Printf("[DEBUG] Private fork pull request workflow settings are unavailable: %s", err)
+	default:
+		return err
+	}
+
+	return d.Set("private_fork_pr_workflows", privateFork)
+}
+
+// resetActionsWorkflowSettings resets the settings to GitHub's defaults. The retention is reset to the default
+// retention, or to the maximum retention when it is lower or there is no default.
+func resetActionsWorkflowSettings(ctx context.Context, api actionsWorkflowSettingsAPI, defaultRetentionDays int) error {
+	retention, _, err := api.getRetention(ctx)
+	if err != nil {
+		return err
+	}
+	days := defaultRetentionDays
+	if maximum := retention.GetMaximumAllowedDays(); maximum > 0 && (days == 0 || maximum < days) {
+		days = maximum
+	}
+	if days > 0 {
+		if _, err = api.updateRetention(ctx, github.ArtifactPeriodOpt{Days: new(days)}); err != nil {
+			return fmt.Errorf("error resetting artifact and log retention: %w", err)
+		}
+	}
+
+	_, err = api.updateApproval(ctx, github.ContributorApprovalPermissions{ApprovalPolicy: defaultForkPRApprovalPolicy})
+	if err != nil && !isActionsWorkflowSettingUnavailable(err) {
+		return fmt.Errorf("error resetting fork pull request approval policy: %w", err)
+	}
+
+	_, err = api.updatePrivateFork(ctx, &github.WorkflowsPermissionsOpt{RunWorkflowsFromForkPullRequests: false})
+	if err != nil && !isActionsWorkflowSettingUnavailable(err) {
+		return fmt.Errorf("error resetting private fork pull request workflow settings: %w", err)
+	}
+
+	return nil
+}
+
+// diffArtifactAndLogRetentionDays validates the planned retention against the maximum retention at plan time, as
+// returned by maximumDays. The retention isn't validated when the maximum is unknown.
+func diffArtifactAndLogRetentionDays(ctx context.Context, d *schema.ResourceDiff, maximumDays func(ctx context.Context) (int, error)) error {
+	if !d.HasChange("artifact_and_log_retention_days") || !d.NewValueKnown("artifact_and_log_retention_days") {
+		return nil
+	}
+	days, ok := d.GetOk("artifact_and_log_retention_days")
+	if !ok {
+		return nil
+	}
+
+	maximum, err := maximumDays(ctx)
+	if err != nil {
+		if isActionsWorkflowSettingUnavailable(err) {
+			log.Printf("[DEBUG] Not validating artifact and log retention, the maximum retention is unavailable: %s", err)
+			return nil
+		}
+		return err
+	}
+
+	if maximum > 0 && days.(int) > maximum {
+		return fmt.Errorf("artifact_and_log_retention_days of %d exceeds the maximum retention of %d days", days, maximum)
+	}
+
+	return nil
+}
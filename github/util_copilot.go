@@ -0,0 +1,73 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v84/github"
+)
+
+// listCopilotSeats returns the Copilot seats of an organization.
+func listCopilotSeats(ctx context.Context, client *github.Client, orgName string) ([]*github.CopilotSeatDetails, error) {
+	var all []*github.CopilotSeatDetails
+	opts := &github.ListOptions{PerPage: maxPerPage}
+	for {
+		seats, resp, err := client.Copilot.ListCopilotSeats(ctx, orgName, opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, seats.Seats...)
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	return all, nil
+}
+
+// copilotSeatAssignments returns the teams Copilot seats are assigned to and the users seats are assigned to directly,
+// keyed by lowercased slug and login. Seats are assigned per user, so a team is assigned as long as one of its members
+// has a seat through it. Seats pending cancellation at the end of the billing cycle are no longer considered assigned.
+func copilotSeatAssignments(seats []*github.CopilotSeatDetails) (teams, directUsers map[string]string) {
+	teams, directUsers = make(map[string]string), make(map[string]string)
+	for _, seat := range seats {
+		if seat.GetPendingCancellationDate() != "" {
+			continue
+		}
+		if team := seat.AssigningTeam; team != nil {
+			teams[strings.ToLower(team.GetSlug())] = team.GetSlug()
+		} else if user, ok := seat.GetUser(); ok {
+			directUsers[strings.ToLower(user.GetLogin())] = user.GetLogin()
+		}
+	}
+
+	return teams, directUsers
+}
+
+// addCopilotTeamsWithoutMembers adds the given teams which have no members to teams. The assignment of a team without
+// members can't be read from the seats, so it is considered assigned as long as the team exists.
+func addCopilotTeamsWithoutMembers(ctx context.Context, client *github.Client, orgName string, teams map[string]string, slugs []string) error {
+	for _, slug := range slugs {
+		if _, ok := teams[strings.ToLower(slug)]; ok {
+			continue
+		}
+
+		members, _, err := client.Teams.ListTeamMembersBySlug(ctx, orgName, slug, &github.TeamListTeamMembersOptions{ListOptions: github.ListOptions{PerPage: 1}})
+		if err != nil {
+			var ghErr *github.ErrorResponse
+			if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return err
+		}
+		if len(members) == 0 {
+			teams[strings.ToLower(slug)] = slug
+		}
+	}
+
+	return nil
+}
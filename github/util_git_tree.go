@@ -0,0 +1,221 @@
+package github
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const gitFileMode = "100644"
+
+// gitBlobSHA returns the SHA Git assigns to a blob with the given content, which allows comparing file content
+// against a tree without downloading it.
+func gitBlobSHA(content string) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "blob %d\x00", len(content))
+	h.Write([]byte(content))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// getRepositoryTreeEntries returns the blob entries of the tree of commit for the given paths. Paths that do not
+// exist in the tree are omitted. Truncated trees of large repositories are resolved path by path.
+func getRepositoryTreeEntries(ctx context.Context, client *github.Client, owner, repo string, commit *github.Commit, paths []string) (map[string]*github.TreeEntry, error) {
+	entries := make(map[string]*github.TreeEntry, len(paths))
+
+	tree, _, err := client.Git.GetTree(ctx, owner, repo, commit.GetTree().GetSHA(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tree.GetTruncated() {
+		for _, entry := range tree.Entries {
+			if entry.GetType() == "blob" && slices.Contains(paths, entry.GetPath()) {
+				entries[entry.GetPath()] = entry
+			}
+		}
+		return entries, nil
+	}
+
+	tflog.Debug(ctx, "Repository tree is truncated, looking up files individually", map[string]any{
+		"files": len(paths),
+	})
+	opts := &github.RepositoryContentGetOptions{Ref: commit.GetSHA()}
+	for _, path := range paths {
+		fc, _, _, err := client.Repositories.GetContents(ctx, owner, repo, path, opts)
+		if err != nil {
+			var ghErr *github.ErrorResponse
+			if errors.As(err, &ghErr) && ghErr.Response.StatusCode == http.StatusNotFound {
+				continue
+			}
+			return nil, err
+		}
+		if fc == nil {
+			continue
+		}
+
+		mode := gitFileMode
+		if fc.GetType() == "symlink" {
+			mode = "120000"
+		}
+		entries[path] = &github.TreeEntry{
+			Path: new(path),
+			SHA:  fc.SHA,
+			Mode: new(mode),
+			Type: new("blob"),
+		}
+	}
+
+	return entries, nil
+}
+
+// createRepositoryFilesCommit creates a commit on top of parent that writes files and deletes the paths in remove.
+// Files whose content is unchanged and paths that are already absent are skipped. The returned commit is nil if
+// there is nothing to commit. No reference is updated.
+func createRepositoryFilesCommit(ctx context.Context, client *github.Client, owner, repo string, parent *github.Commit, files map[string]string, remove []string, message string, author *github.CommitAuthor) (*github.Commit, error) {
+	paths := make([]string, 0, len(files)+len(remove))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	paths = append(paths, remove...)
+
+	current, err := getRepositoryTreeEntries(ctx, client, owner, repo, parent, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*github.TreeEntry
+	for _, path := range paths[:len(files)] {
+		content := files[path]
+
+		mode := gitFileMode
+		if entry, ok := current[path]; ok {
+			if entry.GetSHA() == gitBlobSHA(content) {
+				continue
+			}
+			mode = entry.GetMode()
+		}
+
+		blob, _, err := client.Git.CreateBlob(ctx, owner, repo, github.Blob{
+			Content:  new(content),
+			Encoding: new("utf-8"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error creating blob for %s: %w", path, err)
+		}
+
+		entries = append(entries, &github.TreeEntry{
+			Path: new(path),
+			Mode: new(mode),
+			Type: new("blob"),
+			SHA:  blob.SHA,
+		})
+	}
+	for _, path := range remove {
+		entry, ok := current[path]
+		if !ok {
+			continue
+		}
+
+		// A tree entry without SHA and content deletes the path.
+		entries = append(entries, &github.TreeEntry{
+			Path: new(path),
+			Mode: entry.Mode,
+			Type: new("blob"),
+		})
+	}
+
+	if len(entries) == 0 {
+		tflog.Debug(ctx, "Repository files are up to date, skipping commit")
+		return nil, nil
+	}
+
+	tree, _, err := client.Git.CreateTree(ctx, owner, repo, parent.GetTree().GetSHA(), entries)
+	if err != nil {
+		return nil, err
+	}
+
+	commit := github.Commit{
+		Message: new(message),
+		Tree:    tree,
+		Parents: []*github.Commit{{SHA: parent.SHA}},
+	}
+	if author != nil {
+		commit.Author = author
+		commit.Committer = author
+	}
+
+	created, _, err := client.Git.CreateCommit(ctx, owner, repo, commit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Debug(ctx, "Created commit for repository files", map[string]any{
+		"commit_sha": created.GetSHA(),
+		"changes":    len(entries),
+	})
+
+	return created, nil
+}
+
+// getBranchHeadCommit returns the commit the branch points to.
+func getBranchHeadCommit(ctx context.Context, client *github.Client, owner, repo, branch string) (*github.Commit, error) {
+	ref, _, err := client.Git.GetRef(ctx, owner, repo, "heads/"+branch)
+	if err != nil {
+		return nil, err
+	}
+
+	commit, _, err := client.Git.GetCommit(ctx, owner, repo, ref.GetObject().GetSHA())
+	if err != nil {
+		return nil, err
+	}
+
+	return commit, nil
+}
+
+// flattenRepositoryFiles returns the files as they exist in the tree of commit. Files that no longer exist are
+// omitted, and only files whose blob differs from the known content are downloaded.
+func flattenRepositoryFiles(ctx context.Context, client *github.Client, owner, repo string, commit *github.Commit, files map[string]string) ([]any, error) {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	entries, err := getRepositoryTreeEntries(ctx, client, owner, repo, commit, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]any, 0, len(paths))
+	for _, path := range paths {
+		entry, ok := entries[path]
+		if !ok {
+			tflog.Info(ctx, "Repository file no longer exists in GitHub", map[string]any{"file": path})
+			continue
+		}
+
+		content := files[path]
+		if entry.GetSHA() != gitBlobSHA(content) {
+			raw, _, err := client.Git.GetBlobRaw(ctx, owner, repo, entry.GetSHA())
+			if err != nil {
+				return nil, err
+			}
+			content = string(raw)
+		}
+
+		result = append(result, map[string]any{
+			"path":    path,
+			"content": content,
+		})
+	}
+
+	return result, nil
+}
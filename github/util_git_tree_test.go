@@ -0,0 +1,129 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-github/v84/github"
+)
+
+func TestGitBlobSHA(t *testing.T) {
+	for content, want := range map[string]string{
+		"":    "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391",
+		"bar": "ba0e162e1c47469e3fe4b393a8bf8c569f302116",
+	} {
+		if got := gitBlobSHA(content); got != want {
+			t.Errorf("gitBlobSHA(%q) = %q; want %q", content, got, want)
+		}
+	}
+}
+
+func TestCreateRepositoryFilesCommit(t *testing.T) {
+	newClient := func(t *testing.T, mux *http.ServeMux) *github.Client {
+		return newTestOwner(t, mux, "test-owner", false).v3client
+	}
+	parent := &github.Commit{SHA: new("c0"), Tree: &github.Tree{SHA: new("t0")}}
+
+	treeHandler := func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, `{
+  "sha": "t0",
+  "truncated": false,
+  "tree": [
+    {"path": "unchanged.txt", "mode": "100644", "type": "blob", "sha": "ba0e162e1c47469e3fe4b393a8bf8c569f302116"},
+    {"path": "script.sh", "mode": "100755", "type": "blob", "sha": "0000000000000000000000000000000000000001"},
+    {"path": "obsolete.txt", "mode": "100644", "type": "blob", "sha": "0000000000000000000000000000000000000002"}
+  ]
+}`)
+	}
+
+	t.Run("writes changed files and deletes removed files in one commit", func(t *testing.T) {
+		var entries []map[string]any
+		var commitBody map[string]any
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/o/r/git/trees/t0", treeHandler)
+		mux.HandleFunc("POST /repos/o/r/git/blobs", func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, `{"sha": "b1"}`)
+		})
+		mux.HandleFunc("POST /repos/o/r/git/trees", func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				BaseTree string           `json:"base_tree"`
+				Tree     []map[string]any `json:"tree"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			if body.BaseTree != "t0" {
+				t.Errorf("got base tree %q; want %q", body.BaseTree, "t0")
+			}
+			entries = body.Tree
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, `{"sha": "t1"}`)
+		})
+		mux.HandleFunc("POST /repos/o/r/git/commits", func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&commitBody); err != nil {
+				t.Fatal(err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			mustWrite(w, `{"sha": "c1"}`)
+		})
+		client := newClient(t, mux)
+
+		files := map[string]string{
+			"unchanged.txt": "bar",
+			"script.sh":     "#!/bin/sh\n",
+			"new.txt":       "new",
+		}
+		commit, err := createRepositoryFilesCommit(t.Context(), client, "o", "r", parent, files, []string{"obsolete.txt", "missing.txt"}, "Update files", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if commit.GetSHA() != "c1" {
+			t.Errorf("got commit %q; want %q", commit.GetSHA(), "c1")
+		}
+
+		if len(entries) != 3 {
+			t.Fatalf("got %d tree entries; want 3: %v", len(entries), entries)
+		}
+		byPath := make(map[string]map[string]any)
+		for _, e := range entries {
+			byPath[e["path"].(string)] = e
+		}
+		if e := byPath["script.sh"]; e["mode"] != "100755" || e["sha"] != "b1" {
+			t.Errorf("expected script.sh to keep its mode, got %v", e)
+		}
+		if e := byPath["new.txt"]; e["mode"] != gitFileMode || e["sha"] != "b1" {
+			t.Errorf("unexpected entry for new.txt: %v", e)
+		}
+		if e, ok := byPath["obsolete.txt"]; !ok || e["sha"] != nil {
+			t.Errorf("expected obsolete.txt to be deleted, got %v", e)
+		}
+
+		if parents := commitBody["parents"].([]any); len(parents) != 1 || parents[0] != "c0" {
+			t.Errorf("got parents %v; want [c0]", parents)
+		}
+		if commitBody["tree"] != "t1" {
+			t.Errorf("got tree %v; want t1", commitBody["tree"])
+		}
+	})
+
+	t.Run("skips the commit when nothing changed", func(t *testing.T) {
+		mux := http.NewServeMux()
+		mux.HandleFunc("GET /repos/o/r/git/trees/t0", treeHandler)
+		client := newClient(t, mux)
+
+		commit, err := createRepositoryFilesCommit(t.Context(), client, "o", "r", parent, map[string]string{"unchanged.txt": "bar"}, []string{"missing.txt"}, "Update files", nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if commit != nil {
+			t.Errorf("expected no commit, got %v", commit)
+		}
+	})
+}
@@ -0,0 +1,77 @@
+package github
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// interactionLimit is the payload setting interaction limits. github.InteractionRestriction doesn't support the expiry.
+type interactionLimit struct {
+	Limit  string `json:"limit"`
+	Expiry string `json:"expiry,omitempty"`
+}
+
+// interactionLimitSchema returns the schema of the limits shared by the organization and repository resources.
+func interactionLimitSchema(level string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"limit": {
+			Type:             schema.TypeString,
+			Required:         true,
+			Description:      "The users who can comment, open issues or create pull requests in the " + level + ". Can be one of: 'existing_users', 'contributors_only' or 'collaborators_only'.",
+			ValidateDiagFunc: validateValueFunc([]string{"existing_users", "contributors_only", "collaborators_only"}),
+		},
+		"expiry": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Default:          "one_day",
+			Description:      "How long the limit applies for, from when it is set. Can be one of: 'one_day', 'three_days', 'one_week', 'one_month' or 'six_months'.",
+			ValidateDiagFunc: validateValueFunc([]string{"one_day", "three_days", "one_week", "one_month", "six_months"}),
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the limit expires.",
+		},
+	}
+}
+
+// setInteractionLimit sets the interaction limit at the given path, which is restarted when it is set again.
+func setInteractionLimit(ctx context.Context, client *github.Client, path string, d *schema.ResourceData) error {
+	req, err := client.NewRequest("PUT", path, interactionLimit{
+		Limit:  d.Get("limit").(string),
+		Expiry: d.Get("expiry").(string),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Do(ctx, req, nil)
+	return err
+}
+
+// flattenInteractionLimit sets the limit in the state and reports whether it is active. Limits which expired, or which
+// are inherited from another origin, such as the organization of a repository, aren't active.
+func flattenInteractionLimit(d *schema.ResourceData, restriction *github.InteractionRestriction, origin string) (bool, error) {
+	if restriction.GetLimit() == "" || restriction.GetOrigin() != origin {
+		return false, nil
+	}
+	if expiresAt := restriction.ExpiresAt; expiresAt != nil && !expiresAt.After(time.Now()) {
+		return false, nil
+	}
+
+	if err := d.Set("limit", restriction.GetLimit()); err != nil {
+		return false, err
+	}
+	var expiresAt string
+	if restriction.ExpiresAt != nil {
+		expiresAt = restriction.ExpiresAt.Format(time.RFC3339)
+	}
+	if err := d.Set("expires_at", expiresAt); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
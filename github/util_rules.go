@@ -182,6 +182,80 @@ func flattenBypassActors(bypassActors []*github.BypassActor) []any {
 	return actorsSlice
 }
 
+// repositoryPropertyTargetResource is the schema of a property condition target of an organization or enterprise ruleset.
+func repositoryPropertyTargetResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the property to target.",
+			},
+			"property_values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The values to match for the property.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"source": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "custom",
+				Description:      "The source of the property. Can be one of: `custom` or `system`. Defaults to `custom`.",
+				ValidateDiagFunc: validateValueFunc([]string{"custom", "system"}),
+			},
+		},
+	}
+}
+
+func expandRepositoryPropertyTargets(input []any) []*github.RepositoryRulesetRepositoryPropertyTargetParameters {
+	targets := make([]*github.RepositoryRulesetRepositoryPropertyTargetParameters, 0, len(input))
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		inputTarget := v.(map[string]any)
+		values := make([]string, 0)
+		for _, value := range inputTarget["property_values"].([]any) {
+			if value != nil {
+				values = append(values, value.(string))
+			}
+		}
+
+		target := &github.RepositoryRulesetRepositoryPropertyTargetParameters{
+			Name:           inputTarget["name"].(string),
+			PropertyValues: values,
+		}
+		if source, ok := inputTarget["source"].(string); ok && source != "" {
+			target.Source = &source
+		}
+		targets = append(targets, target)
+	}
+	return targets
+}
+
+func flattenRepositoryPropertyTargets(targets []*github.RepositoryRulesetRepositoryPropertyTargetParameters) []map[string]any {
+	result := make([]map[string]any, 0, len(targets))
+	for _, target := range targets {
+		if target == nil {
+			continue
+		}
+		// GitHub omits the source of custom properties.
+		source := "custom"
+		if target.Source != nil && *target.Source != "" {
+			source = *target.Source
+		}
+		result = append(result, map[string]any{
+			"name":            target.Name,
+			"property_values": target.PropertyValues,
+			"source":          source,
+		})
+	}
+	return result
+}
+
 func expandConditions(input []any, org bool) *github.RepositoryRulesetConditions {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -215,7 +289,7 @@ func expandConditions(input []any, org bool) *github.RepositoryRulesetConditions
 
 	// org-only fields
 	if org {
-		// repository_name and repository_id
+		// repository_name, repository_id and repository_property
 		if v, ok := inputConditions["repository_name"].([]any); ok && v != nil && len(v) != 0 {
 			inputRepositoryName := v[0].(map[string]any)
 			include := make([]string, 0)
@@ -250,6 +324,15 @@ func expandConditions(input []any, org bool) *github.RepositoryRulesetConditions
 			}
 
 			rulesetConditions.RepositoryID = &github.RepositoryRulesetRepositoryIDsConditionParameters{RepositoryIDs: repositoryIDs}
+		} else if v, ok := inputConditions["repository_property"].([]any); ok && v != nil && len(v) != 0 && v[0] != nil {
+			inputRepositoryProperty := v[0].(map[string]any)
+			include, _ := inputRepositoryProperty["include"].([]any)
+			exclude, _ := inputRepositoryProperty["exclude"].([]any)
+
+			rulesetConditions.RepositoryProperty = &github.RepositoryRulesetRepositoryPropertyConditionParameters{
+				Include: expandRepositoryPropertyTargets(include),
+				Exclude: expandRepositoryPropertyTargets(exclude),
+			}
 		}
 	}
 
@@ -296,11 +379,124 @@ func flattenConditions(ctx context.Context, conditions *github.RepositoryRuleset
 		if conditions.RepositoryID != nil {
 			conditionsMap["repository_id"] = conditions.RepositoryID.RepositoryIDs
 		}
+
+		if conditions.RepositoryProperty != nil {
+			conditionsMap["repository_property"] = []map[string]any{{
+				"include": flattenRepositoryPropertyTargets(conditions.RepositoryProperty.Include),
+				"exclude": flattenRepositoryPropertyTargets(conditions.RepositoryProperty.Exclude),
+			}}
+		}
 	}
 
 	return []any{conditionsMap}
 }
 
+// expandEnterpriseConditions expands the conditions of an enterprise ruleset, which target organizations as well as
+// the repositories within them.
+func expandEnterpriseConditions(input []any) *github.RepositoryRulesetConditions {
+	rulesetConditions := expandConditions(input, true)
+	if rulesetConditions == nil {
+		return nil
+	}
+	inputConditions := input[0].(map[string]any)
+
+	if v, ok := inputConditions["organization_name"].([]any); ok && len(v) != 0 && v[0] != nil {
+		inputOrganizationName := v[0].(map[string]any)
+		include := make([]string, 0)
+		exclude := make([]string, 0)
+
+		for _, v := range inputOrganizationName["include"].([]any) {
+			if v != nil {
+				include = append(include, v.(string))
+			}
+		}
+
+		for _, v := range inputOrganizationName["exclude"].([]any) {
+			if v != nil {
+				exclude = append(exclude, v.(string))
+			}
+		}
+
+		rulesetConditions.OrganizationName = &github.RepositoryRulesetOrganizationNamesConditionParameters{
+			Include: include,
+			Exclude: exclude,
+		}
+	} else if v, ok := inputConditions["organization_id"].([]any); ok && len(v) != 0 {
+		organizationIDs := make([]int64, 0)
+
+		for _, v := range v {
+			if v != nil {
+				organizationIDs = append(organizationIDs, toInt64(v))
+			}
+		}
+
+		rulesetConditions.OrganizationID = &github.RepositoryRulesetOrganizationIDsConditionParameters{OrganizationIDs: organizationIDs}
+	} else if v, ok := inputConditions["organization_property"].([]any); ok && len(v) != 0 && v[0] != nil {
+		inputOrganizationProperty := v[0].(map[string]any)
+		include, _ := inputOrganizationProperty["include"].([]any)
+		exclude, _ := inputOrganizationProperty["exclude"].([]any)
+
+		rulesetConditions.OrganizationProperty = &github.RepositoryRulesetOrganizationPropertyConditionParameters{
+			Include: expandRepositoryPropertyTargets(include),
+			Exclude: expandRepositoryPropertyTargets(exclude),
+		}
+	}
+
+	return rulesetConditions
+}
+
+func flattenEnterpriseConditions(ctx context.Context, conditions *github.RepositoryRulesetConditions) []any {
+	result := flattenConditions(ctx, conditions, true)
+	if len(result) == 0 {
+		return result
+	}
+	conditionsMap := result[0].(map[string]any)
+
+	if conditions.OrganizationName != nil {
+		conditionsMap["organization_name"] = []map[string]any{{
+			"include": conditions.OrganizationName.Include,
+			"exclude": conditions.OrganizationName.Exclude,
+		}}
+	}
+
+	if conditions.OrganizationID != nil {
+		conditionsMap["organization_id"] = conditions.OrganizationID.OrganizationIDs
+	}
+
+	if conditions.OrganizationProperty != nil {
+		conditionsMap["organization_property"] = []map[string]any{{
+			"include": flattenRepositoryPropertyTargets(conditions.OrganizationProperty.Include),
+			"exclude": flattenRepositoryPropertyTargets(conditions.OrganizationProperty.Exclude),
+		}}
+	}
+
+	return result
+}
+
+// computedRulesetSchema returns a read-only copy of a ruleset resource attribute, so that data sources can return
+// rulesets in the same representation as the resources.
+func computedRulesetSchema(s *schema.Schema) *schema.Schema {
+	computed := &schema.Schema{
+		Type:        s.Type,
+		Computed:    true,
+		Description: s.Description,
+		Set:         s.Set,
+	}
+
+	switch elem := s.Elem.(type) {
+	case *schema.Resource:
+		fields := make(map[string]*schema.Schema, len(elem.Schema))
+		for k, v := range elem.Schema {
+			fields[k] = computedRulesetSchema(v)
+		}
+		computed.Elem = &schema.Resource{Schema: fields}
+	case *schema.Schema:
+		computed.Elem = &schema.Schema{Type: elem.Type}
+	}
+
+	return computed
+}
+
 func expandRules(input []any, org bool) *github.RepositoryRulesetRules {
 	if len(input) == 0 || input[0] == nil {
 		return &github.RepositoryRulesetRules{}
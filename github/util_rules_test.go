@@ -626,6 +626,65 @@ func TestFlattenConditions_PushRuleset_WithRepositoryIdOnly(t *testing.T) {
 	}
 }
 
+func TestRoundTripConditions_RepositoryProperty(t *testing.T) {
+	input := []any{
+		map[string]any{
+			"ref_name": []any{
+				map[string]any{
+					"include": []any{"~DEFAULT_BRANCH"},
+					"exclude": []any{},
+				},
+			},
+			"repository_property": []any{
+				map[string]any{
+					"include": []any{
+						map[string]any{"name": "tier", "property_values": []any{"critical", "high"}, "source": "custom"},
+					},
+					"exclude": []any{
+						map[string]any{"name": "fork", "property_values": []any{"true"}, "source": "system"},
+					},
+				},
+			},
+		},
+	}
+
+	conditions := expandConditions(input, true)
+	if conditions.RepositoryName != nil || conditions.RepositoryID != nil {
+		t.Fatalf("Expected only repository_property to be set, got %+v", conditions)
+	}
+	if conditions.RepositoryProperty == nil || len(conditions.RepositoryProperty.Include) != 1 || len(conditions.RepositoryProperty.Exclude) != 1 {
+		t.Fatalf("Expected 1 included and 1 excluded property, got %+v", conditions.RepositoryProperty)
+	}
+	include := conditions.RepositoryProperty.Include[0]
+	if include.Name != "tier" || len(include.PropertyValues) != 2 || include.GetSource() != "custom" {
+		t.Errorf("Unexpected included property: %+v", include)
+	}
+
+	// GitHub omits the source of custom properties.
+	include.Source = nil
+
+	result := flattenConditions(t.Context(), conditions, true)
+	if len(result) != 1 {
+		t.Fatalf("Expected 1 conditions block, got %d", len(result))
+	}
+	repositoryProperty, ok := result[0].(map[string]any)["repository_property"].([]map[string]any)
+	if !ok || len(repositoryProperty) != 1 {
+		t.Fatalf("Expected 1 repository_property block, got %v", result[0].(map[string]any)["repository_property"])
+	}
+
+	flattenedInclude := repositoryProperty[0]["include"].([]map[string]any)
+	if len(flattenedInclude) != 1 || flattenedInclude[0]["name"] != "tier" || flattenedInclude[0]["source"] != "custom" {
+		t.Errorf("Unexpected flattened include: %v", flattenedInclude)
+	}
+	flattenedExclude := repositoryProperty[0]["exclude"].([]map[string]any)
+	if len(flattenedExclude) != 1 || flattenedExclude[0]["name"] != "fork" || flattenedExclude[0]["source"] != "system" {
+		t.Errorf("Unexpected flattened exclude: %v", flattenedExclude)
+	}
+	if values := flattenedExclude[0]["property_values"].([]string); len(values) != 1 || values[0] != "true" {
+		t.Errorf("Expected property values [true], got %v", values)
+	}
+}
+
 func TestExpandRequiredReviewers(t *testing.T) {
 	input := []any{
 		map[string]any{
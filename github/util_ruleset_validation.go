@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
 	"github.com/google/go-github/v84/github"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -171,17 +172,78 @@ func validateConditionsFieldForBranchAndTagTargets(ctx context.Context, target g
 		return fmt.Errorf("ref_name must be set for %s target", target)
 	}
 
-	// Repository rulesets don't have repository_name or repository_id, only org rulesets do.
+	// Repository rulesets don't have repository_name, repository_id or repository_property, only org rulesets do.
 	if isOrg {
-		if (conditions["repository_name"] == nil || len(conditions["repository_name"].([]any)) == 0) && (conditions["repository_id"] == nil || len(conditions["repository_id"].([]any)) == 0) {
-			tflog.Debug(ctx, fmt.Sprintf("Missing repository_name or repository_id for %s target", target), map[string]any{"target": target})
-			return fmt.Errorf("either repository_name or repository_id must be set for %s target", target)
+		if err := validateRepositoryConditions(ctx, target, conditions); err != nil {
+			return err
 		}
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Conditions validation passed for %s target", target))
 	return nil
 }
 
+// validateRepositoryConditions checks that exactly one of repository_name, repository_id or repository_property is set.
+func validateRepositoryConditions(ctx context.Context, target github.RulesetTarget, conditions map[string]any) error {
+	set := make([]string, 0)
+	for _, key := range []string{"repository_name", "repository_id", "repository_property"} {
+		if v, ok := conditions[key].([]any); ok && len(v) > 0 {
+			set = append(set, key)
+		}
+	}
+
+	if len(set) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Missing repository_name, repository_id or repository_property for %s target", target), map[string]any{"target": target})
+		return fmt.Errorf("one of repository_name, repository_id or repository_property must be set for %s target", target)
+	}
+	if len(set) > 1 {
+		tflog.Debug(ctx, fmt.Sprintf("Conflicting repository conditions for %s target", target), map[string]any{"target": target, "conditions": set})
+		return fmt.Errorf("only one of repository_name, repository_id or repository_property can be set for %s target, got: %s", target, strings.Join(set, ", "))
+	}
+
+	return nil
+}
+
+// validateEnterpriseRulesetConditions checks that an enterprise ruleset targets both organizations and repositories,
+// with exactly one condition each.
+func validateEnterpriseRulesetConditions(ctx context.Context, d *schema.ResourceDiff) error {
+	target := github.RulesetTarget(d.Get("target").(string))
+	conditionsRaw := d.Get("conditions").([]any)
+	if len(conditionsRaw) == 0 || conditionsRaw[0] == nil {
+		return fmt.Errorf("conditions must be set for enterprise rulesets")
+	}
+	conditions := conditionsRaw[0].(map[string]any)
+
+	if err := validateOrganizationConditions(ctx, target, conditions); err != nil {
+		return err
+	}
+	if target == github.RulesetTargetPush {
+		return validateRepositoryConditions(ctx, target, conditions)
+	}
+	return nil
+}
+
+// validateOrganizationConditions checks that exactly one of organization_name, organization_id or
+// organization_property is set.
+func validateOrganizationConditions(ctx context.Context, target github.RulesetTarget, conditions map[string]any) error {
+	set := make([]string, 0)
+	for _, key := range []string{"organization_name", "organization_id", "organization_property"} {
+		if v, ok := conditions[key].([]any); ok && len(v) > 0 {
+			set = append(set, key)
+		}
+	}
+
+	if len(set) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Missing organization_name, organization_id or organization_property for %s target", target), map[string]any{"target": target})
+		return fmt.Errorf("one of organization_name, organization_id or organization_property must be set for %s target", target)
+	}
+	if len(set) > 1 {
+		tflog.Debug(ctx, fmt.Sprintf("Conflicting organization conditions for %s target", target), map[string]any{"target": target, "conditions": set})
+		return fmt.Errorf("only one of organization_name, organization_id or organization_property can be set for %s target, got: %s", target, strings.Join(set, ", "))
+	}
+
+	return nil
+}
+
 func validateConditionsFieldForPushTarget(ctx context.Context, conditions map[string]any) error {
 	tflog.Debug(ctx, "Validating conditions field for push target", map[string]any{"target": "push", "conditions": conditions})
 
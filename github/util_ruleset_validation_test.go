@@ -156,6 +156,32 @@ func Test_validateConditionsFieldForBranchAndTagTargets(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name:   "valid branch target with ref_name and repository_property",
+			target: github.RulesetTargetBranch,
+			conditions: map[string]any{
+				"ref_name": []any{map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}}},
+				"repository_property": []any{map[string]any{
+					"include": []any{map[string]any{"name": "tier", "property_values": []any{"critical"}, "source": "custom"}},
+					"exclude": []any{},
+				}},
+			},
+			expectError: false,
+		},
+		{
+			name:   "invalid branch target with repository_name and repository_property",
+			target: github.RulesetTargetBranch,
+			conditions: map[string]any{
+				"ref_name":        []any{map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}}},
+				"repository_name": []any{map[string]any{"include": []any{"~ALL"}, "exclude": []any{}}},
+				"repository_property": []any{map[string]any{
+					"include": []any{map[string]any{"name": "tier", "property_values": []any{"critical"}, "source": "custom"}},
+					"exclude": []any{},
+				}},
+			},
+			expectError: true,
+			errorMsg:    "only one of repository_name, repository_id or repository_property can be set for branch target, got: repository_name, repository_property",
+		},
 		{
 			name:   "invalid branch target without ref_name",
 			target: github.RulesetTargetBranch,
@@ -172,7 +198,7 @@ func Test_validateConditionsFieldForBranchAndTagTargets(t *testing.T) {
 				"ref_name": []any{map[string]any{"include": []any{"~DEFAULT_BRANCH"}, "exclude": []any{}}},
 			},
 			expectError: true,
-			errorMsg:    "either repository_name or repository_id must be set for branch target",
+			errorMsg:    "one of repository_name, repository_id or repository_property must be set for branch target",
 		},
 		{
 			name:   "invalid tag target with nil repository_name and repository_id",
@@ -183,7 +209,7 @@ func Test_validateConditionsFieldForBranchAndTagTargets(t *testing.T) {
 				"repository_id":   nil,
 			},
 			expectError: true,
-			errorMsg:    "either repository_name or repository_id must be set for tag target",
+			errorMsg:    "one of repository_name, repository_id or repository_property must be set for tag target",
 		},
 		{
 			name:   "invalid branch target with empty repository_name and repository_id slices",
@@ -194,7 +220,7 @@ func Test_validateConditionsFieldForBranchAndTagTargets(t *testing.T) {
 				"repository_id":   []any{},
 			},
 			expectError: true,
-			errorMsg:    "either repository_name or repository_id must be set for branch target",
+			errorMsg:    "one of repository_name, repository_id or repository_property must be set for branch target",
 		},
 	}
 
@@ -3,12 +3,15 @@ package github
 import (
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"unicode"
 
 	"github.com/google/go-github/v84/github"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
 )
 
 func Test_escapeIDPart(t *testing.T) {
@@ -589,6 +592,27 @@ func TestGithubUtilValidateSecretName(t *testing.T) {
 	}
 }
 
+// newTestOwner returns an Owner of the given name whose REST and GraphQL clients are served by handler. The URL of the
+// server is the BaseURL of the REST client.
+func newTestOwner(t *testing.T, handler http.Handler, name string, isOrganization bool) *Owner {
+	t.Helper()
+
+	ts := httptest.NewServer(handler)
+	t.Cleanup(ts.Close)
+
+	u, _ := url.Parse(ts.URL + "/")
+	client := github.NewClient(nil)
+	client.BaseURL = u
+	client.UploadURL = u
+
+	return &Owner{
+		name:           name,
+		v3client:       client,
+		v4client:       githubv4.NewEnterpriseClient(ts.URL+"/graphql", nil),
+		IsOrganization: isOrganization,
+	}
+}
+
 func ghErrorResponse(statusCode int) *github.ErrorResponse {
 	return &github.ErrorResponse{
 		Response: &http.Response{StatusCode: statusCode},
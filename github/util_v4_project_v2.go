@@ -0,0 +1,170 @@
+package github
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shurcooL/githubv4"
+)
+
+// projectV2Fragment holds the attributes of a ProjectV2 node shared by the resource and data source.
+type projectV2Fragment struct {
+	ID               githubv4.ID
+	Number           githubv4.Int
+	Title            githubv4.String
+	ShortDescription githubv4.String
+	Readme           githubv4.String
+	Public           githubv4.Boolean
+	Closed           githubv4.Boolean
+	URL              githubv4.String `graphql:"url"`
+}
+
+// projectV2FieldFragment holds the attributes of any of the ProjectV2FieldConfiguration union members. As the
+// response is decoded into every fragment with a matching key, Typename determines which one applies.
+type projectV2FieldFragment struct {
+	Typename githubv4.String `graphql:"__typename"`
+	Field    struct {
+		ID         githubv4.ID
+		DatabaseID githubv4.Int `graphql:"databaseId"`
+		Name       githubv4.String
+		DataType   githubv4.String
+	} `graphql:"... on ProjectV2Field"`
+	SingleSelectField struct {
+		ID         githubv4.ID
+		DatabaseID githubv4.Int `graphql:"databaseId"`
+		Name       githubv4.String
+		DataType   githubv4.String
+		Options    []struct {
+			ID          githubv4.String
+			Name        githubv4.String
+			Color       githubv4.String
+			Description githubv4.String
+		}
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	IterationField struct {
+		ID            githubv4.ID
+		DatabaseID    githubv4.Int `graphql:"databaseId"`
+		Name          githubv4.String
+		DataType      githubv4.String
+		Configuration struct {
+			Duration   githubv4.Int
+			Iterations []projectV2IterationFragment
+		}
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+type projectV2IterationFragment struct {
+	ID        githubv4.String
+	Title     githubv4.String
+	StartDate githubv4.String
+	Duration  githubv4.Int
+}
+
+// projectV2Field is the flattened representation of a projectV2FieldFragment.
+type projectV2Field struct {
+	ID                string
+	DatabaseID        int
+	Name              string
+	DataType          string
+	Options           []map[string]any
+	IterationDuration int
+	Iterations        []map[string]any
+}
+
+// flatten collapses the union members of the fragment into a single projectV2Field.
+func (f projectV2FieldFragment) flatten() projectV2Field {
+	switch f.Typename {
+	case "ProjectV2Field":
+		return projectV2Field{
+			ID:         f.Field.ID.(string),
+			DatabaseID: int(f.Field.DatabaseID),
+			Name:       string(f.Field.Name),
+			DataType:   string(f.Field.DataType),
+		}
+	case "ProjectV2SingleSelectField":
+		options := make([]map[string]any, 0, len(f.SingleSelectField.Options))
+		for _, o := range f.SingleSelectField.Options {
+			options = append(options, map[string]any{
+				"id":          string(o.ID),
+				"name":        string(o.Name),
+				"color":       string(o.Color),
+				"description": string(o.Description),
+			})
+		}
+		return projectV2Field{
+			ID:         f.SingleSelectField.ID.(string),
+			DatabaseID: int(f.SingleSelectField.DatabaseID),
+			Name:       string(f.SingleSelectField.Name),
+			DataType:   string(f.SingleSelectField.DataType),
+			Options:    options,
+		}
+	case "ProjectV2IterationField":
+		iterations := make([]map[string]any, 0, len(f.IterationField.Configuration.Iterations))
+		for _, i := range f.IterationField.Configuration.Iterations {
+			iterations = append(iterations, map[string]any{
+				"id":         string(i.ID),
+				"title":      string(i.Title),
+				"start_date": string(i.StartDate),
+				"duration":   int(i.Duration),
+			})
+		}
+		return projectV2Field{
+			ID:                f.IterationField.ID.(string),
+			DatabaseID:        int(f.IterationField.DatabaseID),
+			Name:              string(f.IterationField.Name),
+			DataType:          string(f.IterationField.DataType),
+			IterationDuration: int(f.IterationField.Configuration.Duration),
+			Iterations:        iterations,
+		}
+	}
+
+	return projectV2Field{}
+}
+
+// getProjectV2OwnerID returns the node ID of the organization or user that the provider is configured for.
+func getProjectV2OwnerID(ctx context.Context, meta *Owner) (githubv4.ID, error) {
+	var query struct {
+		RepositoryOwner struct {
+			ID githubv4.ID
+		} `graphql:"repositoryOwner(login:$login)"`
+	}
+	variables := map[string]any{
+		"login": githubv4.String(meta.name),
+	}
+
+	if err := meta.v4client.Query(ctx, &query, variables); err != nil {
+		return nil, err
+	}
+
+	return query.RepositoryOwner.ID, nil
+}
+
+// getProjectV2Number returns the number of the project with the given node ID.
+func getProjectV2Number(ctx context.Context, meta *Owner, projectID string) (int, error) {
+	var query struct {
+		Node struct {
+			ProjectV2 struct {
+				Number githubv4.Int
+			} `graphql:"... on ProjectV2"`
+		} `graphql:"node(id:$id)"`
+	}
+	variables := map[string]any{
+		"id": githubv4.ID(projectID),
+	}
+
+	if err := meta.v4client.Query(ctx, &query, variables); err != nil {
+		return 0, err
+	}
+
+	return int(query.Node.ProjectV2.Number), nil
+}
+
+// isGraphQLNotFound reports whether err is a GraphQL error for a node or object that does not exist.
+func isGraphQLNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return strings.Contains(err.Error(), "Could not resolve to a node with the global id") ||
+		strings.Contains(err.Error(), "Could not resolve to a ProjectV2 with the number")
+}
@@ -0,0 +1,319 @@
+package github
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func newProjectV2TestOwner(t *testing.T, response string) *Owner {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		mustWrite(w, response)
+	})
+
+	return newTestOwner(t, mux, "test-owner", false)
+}
+
+func TestProjectV2FieldRead(t *testing.T) {
+	t.Run("flattens a single select field", func(t *testing.T) {
+		meta := newProjectV2TestOwner(t, `{
+  "data": {
+    "node": {
+      "__typename": "ProjectV2SingleSelectField",
+      "project": {"id": "PVT_1"},
+      "id": "PVTSSF_1",
+      "databaseId": 42,
+      "name": "Status",
+      "dataType": "SINGLE_SELECT",
+      "options": [
+        {"id": "a1", "name": "Todo", "color": "GRAY", "description": ""},
+        {"id": "b2", "name": "Done", "color": "GREEN", "description": "Finished"}
+      ]
+    }
+  }
+}`)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubProjectV2Field().Schema, map[string]any{})
+		d.SetId("PVTSSF_1")
+
+		if diags := resourceGithubProjectV2FieldRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("project_id").(string); got != "PVT_1" {
+			t.Errorf("got project_id %q; want %q", got, "PVT_1")
+		}
+		if got := d.Get("data_type").(string); got != "SINGLE_SELECT" {
+			t.Errorf("got data_type %q; want %q", got, "SINGLE_SELECT")
+		}
+		if got := d.Get("database_id").(int); got != 42 {
+			t.Errorf("got database_id %d; want %d", got, 42)
+		}
+		if got := d.Get("single_select_option.#").(int); got != 2 {
+			t.Fatalf("got %d options; want 2", got)
+		}
+		if got := d.Get("single_select_option.1.id").(string); got != "b2" {
+			t.Errorf("got option id %q; want %q", got, "b2")
+		}
+		if got := d.Get("single_select_option.1.color").(string); got != "GREEN" {
+			t.Errorf("got option color %q; want %q", got, "GREEN")
+		}
+	})
+
+	t.Run("flattens an iteration field", func(t *testing.T) {
+		meta := newProjectV2TestOwner(t, `{
+  "data": {
+    "node": {
+      "__typename": "ProjectV2IterationField",
+      "project": {"id": "PVT_1"},
+      "id": "PVTIF_1",
+      "databaseId": 43,
+      "name": "Sprint",
+      "dataType": "ITERATION",
+      "configuration": {
+        "duration": 14,
+        "iterations": [
+          {"id": "i1", "title": "Sprint 1", "startDate": "2026-01-05", "duration": 14}
+        ]
+      }
+    }
+  }
+}`)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubProjectV2Field().Schema, map[string]any{})
+		d.SetId("PVTIF_1")
+
+		if diags := resourceGithubProjectV2FieldRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got := d.Get("iterations.0.id").(string); got != "i1" {
+			t.Errorf("got iteration id %q; want %q", got, "i1")
+		}
+		if got := d.Get("iteration_configuration.0.start_date").(string); got != "2026-01-05" {
+			t.Errorf("got start_date %q; want %q", got, "2026-01-05")
+		}
+		if got := d.Get("iteration_configuration.0.duration").(int); got != 14 {
+			t.Errorf("got duration %d; want %d", got, 14)
+		}
+		if got := d.Get("single_select_option.#").(int); got != 0 {
+			t.Errorf("got %d options; want 0", got)
+		}
+	})
+
+	t.Run("removes a deleted field from state", func(t *testing.T) {
+		meta := newProjectV2TestOwner(t, `{
+  "data": {"node": null},
+  "errors": [{"type": "NOT_FOUND", "path": ["node"], "message": "Could not resolve to a node with the global id of 'PVTF_1'"}]
+}`)
+
+		d := schema.TestResourceDataRaw(t, resourceGithubProjectV2Field().Schema, map[string]any{})
+		d.SetId("PVTF_1")
+
+		if diags := resourceGithubProjectV2FieldRead(t.Context(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if d.Id() != "" {
+			t.Errorf("expected the field to be removed from state, got ID %q", d.Id())
+		}
+	})
+}
+
+func TestExpandProjectV2SingleSelectOptionUpdates(t *testing.T) {
+	oldOptions := []any{
+		map[string]any{"id": "a1", "name": "Todo", "color": "GRAY", "description": ""},
+		map[string]any{"id": "b2", "name": "Done", "color": "GREEN", "description": ""},
+	}
+	newOptions := []any{
+		map[string]any{"name": "To do", "color": "GRAY", "description": ""},
+		map[string]any{"name": "Doing", "color": "YELLOW", "description": ""},
+		map[string]any{"name": "Done", "color": "GREEN", "description": "Finished"},
+	}
+
+	options := expandProjectV2SingleSelectOptionUpdates(oldOptions, newOptions)
+
+	got, err := json.Marshal(options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The renamed option keeps the ID at its position, and the existing option keeps its ID at a new position.
+	want := `[{"name":"To do","color":"GRAY","description":"","id":"a1"},` +
+		`{"name":"Doing","color":"YELLOW","description":""},` +
+		`{"name":"Done","color":"GREEN","description":"Finished","id":"b2"}]`
+	if string(got) != want {
+		t.Errorf("got options %s; want %s", got, want)
+	}
+}
+
+func TestExpandProjectV2IterationConfiguration(t *testing.T) {
+	configuration := []any{map[string]any{"start_date": "2026-01-05", "duration": 7}}
+	iterations := []any{
+		map[string]any{"id": "i1", "title": "Sprint 1", "start_date": "2026-01-05", "duration": 14},
+		map[string]any{"id": "i2", "title": "Sprint 2", "start_date": "2026-01-19", "duration": 14},
+	}
+
+	input, err := expandProjectV2IterationConfiguration(configuration, iterations)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if input.Duration != 7 {
+		t.Errorf("got duration %d; want 7", input.Duration)
+	}
+	if len(input.Iterations) != 2 {
+		t.Fatalf("got %d iterations; want the 2 existing ones", len(input.Iterations))
+	}
+	if got := input.Iterations[1]; got.Title != "Sprint 2" || got.StartDate.Format(time.DateOnly) != "2026-01-19" || got.Duration != 14 {
+		t.Errorf("got iteration %+v; want Sprint 2", got)
+	}
+}
+
+func TestProjectV2ViewRead(t *testing.T) {
+	meta := newProjectV2TestOwner(t, `{
+  "data": {
+    "node": {
+      "view": {
+        "name": "Board",
+        "layout": "BOARD_LAYOUT",
+        "filter": "is:open",
+        "number": 3,
+        "visibleFields": {
+          "nodes": [
+            {"__typename": "ProjectV2Field", "id": "PVTF_1", "databaseId": 41, "name": "Title", "dataType": "TITLE"},
+            {"__typename": "ProjectV2SingleSelectField", "id": "PVTSSF_1", "databaseId": 42, "name": "Status", "dataType": "SINGLE_SELECT", "options": []}
+          ]
+        }
+      }
+    }
+  }
+}`)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubProjectV2View().Schema, map[string]any{})
+	d.SetId("PVT_1:3")
+
+	if diags := resourceGithubProjectV2ViewRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("layout").(string); got != "board" {
+		t.Errorf("got layout %q; want %q", got, "board")
+	}
+	visibleFields := d.Get("visible_fields").([]any)
+	if len(visibleFields) != 2 || visibleFields[0] != 41 || visibleFields[1] != 42 {
+		t.Errorf("got visible_fields %v; want [41 42]", visibleFields)
+	}
+}
+
+func TestProjectV2ItemRead(t *testing.T) {
+	meta := newProjectV2TestOwner(t, `{
+  "data": {
+    "node": {
+      "id": "PVTI_1",
+      "type": "ISSUE",
+      "isArchived": false,
+      "project": {"id": "PVT_1"},
+      "content": {"id": "I_1"},
+      "fieldValues": {
+        "nodes": [
+          {"__typename": "ProjectV2ItemFieldTextValue", "text": "hello", "field": {"id": "PVTF_text"}},
+          {"__typename": "ProjectV2ItemFieldNumberValue", "number": 3.5, "field": {"id": "PVTF_number"}},
+          {"__typename": "ProjectV2ItemFieldSingleSelectValue", "optionId": "a1", "field": {"id": "PVTSSF_1"}},
+          {"__typename": "ProjectV2ItemFieldDateValue", "date": "2026-02-01", "field": {"id": "PVTF_date"}}
+        ]
+      }
+    }
+  }
+}`)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubProjectV2Item().Schema, map[string]any{
+		"project_id": "PVT_1",
+		"content_id": "I_1",
+		"field_value": []any{
+			map[string]any{"field_id": "PVTF_text", "text": "outdated"},
+			map[string]any{"field_id": "PVTF_number", "number": 1.0},
+			map[string]any{"field_id": "PVTSSF_1", "single_select_option_id": "a1"},
+		},
+	})
+	d.SetId("PVTI_1")
+
+	if diags := resourceGithubProjectV2ItemRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := d.Get("content_type").(string); got != "ISSUE" {
+		t.Errorf("got content_type %q; want %q", got, "ISSUE")
+	}
+
+	values := make(map[string]map[string]any)
+	for _, v := range d.Get("field_value").(*schema.Set).List() {
+		value := v.(map[string]any)
+		values[value["field_id"].(string)] = value
+	}
+
+	if len(values) != 3 {
+		t.Fatalf("got %d field values; want 3 as unconfigured fields are not managed", len(values))
+	}
+	if got := values["PVTF_text"]["text"].(string); got != "hello" {
+		t.Errorf("got text %q; want %q", got, "hello")
+	}
+	if got := values["PVTF_number"]["number"].(float64); got != 3.5 {
+		t.Errorf("got number %v; want %v", got, 3.5)
+	}
+	if got := values["PVTSSF_1"]["single_select_option_id"].(string); got != "a1" {
+		t.Errorf("got single_select_option_id %q; want %q", got, "a1")
+	}
+}
+
+func TestExpandProjectV2FieldValue(t *testing.T) {
+	empty := map[string]any{
+		"field_id":                "PVTF_1",
+		"text":                    "",
+		"number":                  0.0,
+		"date":                    "",
+		"single_select_option_id": "",
+		"iteration_id":            "",
+	}
+	with := func(key string, value any) map[string]any {
+		m := make(map[string]any, len(empty))
+		for k, v := range empty {
+			m[k] = v
+		}
+		m[key] = value
+		return m
+	}
+
+	t.Run("uses the text value", func(t *testing.T) {
+		v, err := expandProjectV2FieldValue(with("text", "hello"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if v.Text == nil || *v.Text != "hello" || v.Number != nil {
+			t.Errorf("unexpected value %+v", v)
+		}
+	})
+
+	t.Run("parses the date value", func(t *testing.T) {
+		v, err := expandProjectV2FieldValue(with("date", "2026-02-01"))
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if v.Date == nil || v.Date.Format("2006-01-02") != "2026-02-01" {
+			t.Errorf("unexpected value %+v", v)
+		}
+	})
+
+	t.Run("falls back to the number value", func(t *testing.T) {
+		v, err := expandProjectV2FieldValue(empty)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if v.Number == nil || *v.Number != 0 {
+			t.Errorf("unexpected value %+v", v)
+		}
+	})
+}
@@ -0,0 +1,266 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-github/v84/github"
+	"github.com/shurcooL/githubv4"
+)
+
+const (
+	// repositoryBatchWindow is how long the first read of a batch waits for concurrent reads to join it.
+	repositoryBatchWindow = 50 * time.Millisecond
+	// repositoryBatchTopics is the number of topics read per repository, which is the most a repository can have.
+	repositoryBatchTopics = 20
+	// maxRepositoryBatchSize is the number of repositories read per query. GraphQL queries are charged one point of
+	// the rate limit per hundred connections they request, so a batch of a hundred topic connections costs one point.
+	// https://docs.github.com/en/graphql/overview/rate-limits-and-query-limits-for-the-graphql-api#calculating-points-for-the-primary-rate-limit
+	maxRepositoryBatchSize = 100
+	// graphQLNodeLimit is the number of nodes a single GraphQL query may request.
+	// https://docs.github.com/en/graphql/overview/rate-limits-and-query-limits-for-the-graphql-api#node-limit
+	graphQLNodeLimit = 500000
+)
+
+// batchedRepository holds the fields of a repository read by a repositoryBatcher.
+type batchedRepository struct {
+	ID                       string
+	DatabaseID               int64 `graphql:"databaseId"`
+	Name                     string
+	NameWithOwner            string
+	Description              string
+	HomepageURL              string `graphql:"homepageUrl"`
+	URL                      string `graphql:"url"`
+	SSHURL                   string `graphql:"sshUrl"`
+	Visibility               string
+	IsPrivate                bool
+	IsArchived               bool
+	IsFork                   bool
+	IsTemplate               bool
+	HasIssuesEnabled         bool
+	HasDiscussionsEnabled    bool
+	HasProjectsEnabled       bool
+	HasWikiEnabled           bool
+	AutoMergeAllowed         bool
+	MergeCommitAllowed       bool
+	RebaseMergeAllowed       bool
+	SquashMergeAllowed       bool
+	AllowUpdateBranch        bool
+	ForkingAllowed           bool
+	DeleteBranchOnMerge      bool
+	WebCommitSignoffRequired bool
+	MergeCommitMessage       string
+	MergeCommitTitle         string
+	SquashMergeCommitMessage string
+	SquashMergeCommitTitle   string
+
+	// The vulnerability alerts setting is only served to repository administrators.
+	HasVulnerabilityAlertsEnabled bool
+	ViewerPermission              githubv4.RepositoryPermission
+
+	PrimaryLanguage *struct {
+		Name string
+	}
+	DefaultBranchRef *struct {
+		Name string
+	}
+	Parent *struct {
+		Name  string
+		Owner struct {
+			Login string
+		}
+	}
+	TemplateRepository *struct {
+		Name  string
+		Owner struct {
+			Login string
+		}
+	}
+	RepositoryTopics struct {
+		Nodes []struct {
+			Topic struct {
+				Name string
+			}
+		}
+	} `graphql:"repositoryTopics(first: 20)"`
+}
+
+// toRepository converts the batched repository to the REST representation read by resourceGithubRepositoryRead.
+// Fields the GraphQL API doesn't serve, such as the default branch of an empty repository, are left unset.
+func (r *batchedRepository) toRepository() *github.Repository {
+	repo := &github.Repository{
+		ID:                       new(r.DatabaseID),
+		NodeID:                   new(r.ID),
+		Name:                     new(r.Name),
+		FullName:                 new(r.NameWithOwner),
+		Description:              new(r.Description),
+		Homepage:                 new(r.HomepageURL),
+		HTMLURL:                  new(r.URL),
+		SVNURL:                   new(r.URL),
+		CloneURL:                 new(r.URL + ".git"),
+		SSHURL:                   new(r.SSHURL),
+		Visibility:               new(strings.ToLower(r.Visibility)),
+		Private:                  new(r.IsPrivate),
+		Archived:                 new(r.IsArchived),
+		Fork:                     new(r.IsFork),
+		IsTemplate:               new(r.IsTemplate),
+		HasIssues:                new(r.HasIssuesEnabled),
+		HasDiscussions:           new(r.HasDiscussionsEnabled),
+		HasProjects:              new(r.HasProjectsEnabled),
+		HasWiki:                  new(r.HasWikiEnabled),
+		AllowAutoMerge:           new(r.AutoMergeAllowed),
+		AllowMergeCommit:         new(r.MergeCommitAllowed),
+		AllowRebaseMerge:         new(r.RebaseMergeAllowed),
+		AllowSquashMerge:         new(r.SquashMergeAllowed),
+		AllowUpdateBranch:        new(r.AllowUpdateBranch),
+		AllowForking:             new(r.ForkingAllowed),
+		DeleteBranchOnMerge:      new(r.DeleteBranchOnMerge),
+		WebCommitSignoffRequired: new(r.WebCommitSignoffRequired),
+		MergeCommitMessage:       new(r.MergeCommitMessage),
+		MergeCommitTitle:         new(r.MergeCommitTitle),
+		SquashMergeCommitMessage: new(r.SquashMergeCommitMessage),
+		SquashMergeCommitTitle:   new(r.SquashMergeCommitTitle),
+		Topics:                   make([]string, 0, len(r.RepositoryTopics.Nodes)),
+	}
+
+	if u, err := url.Parse(r.URL); err == nil {
+		u.Scheme = "git"
+		repo.GitURL = new(u.String() + ".git")
+	}
+	if r.PrimaryLanguage != nil {
+		repo.Language = new(r.PrimaryLanguage.Name)
+	}
+	if r.DefaultBranchRef != nil {
+		repo.DefaultBranch = new(r.DefaultBranchRef.Name)
+	}
+	if r.Parent != nil {
+		repo.Parent = &github.Repository{
+			Name:  new(r.Parent.Name),
+			Owner: &github.User{Login: new(r.Parent.Owner.Login)},
+		}
+	}
+	if r.TemplateRepository != nil {
+		repo.TemplateRepository = &github.Repository{
+			Name:  new(r.TemplateRepository.Name),
+			Owner: &github.User{Login: new(r.TemplateRepository.Owner.Login)},
+		}
+	}
+	for _, node := range r.RepositoryTopics.Nodes {
+		repo.Topics = append(repo.Topics, node.Topic.Name)
+	}
+
+	return repo
+}
+
+// repositoryBatchSize returns the number of repositories read per query, within the GraphQL node limit.
+func repositoryBatchSize() int {
+	return min(maxRepositoryBatchSize, graphQLNodeLimit/(1+repositoryBatchTopics))
+}
+
+type repositoryBatchRequest struct {
+	owner      string
+	name       string
+	done       chan struct{}
+	repository *batchedRepository
+}
+
+// repositoryBatcher aggregates concurrent repository reads into aliased GraphQL queries reading up to
+// repositoryBatchSize repositories each, and fans the results back out to the readers.
+type repositoryBatcher struct {
+	ctx    context.Context
+	client *githubv4.Client
+	size   int
+
+	mu      sync.Mutex
+	pending []*repositoryBatchRequest
+	timer   *time.Timer
+}
+
+func newRepositoryBatcher(ctx context.Context, client *githubv4.Client) *repositoryBatcher {
+	return &repositoryBatcher{ctx: ctx, client: client, size: repositoryBatchSize()}
+}
+
+// get reads a repository as part of a batch. It returns nil if the repository couldn't be read, in which case
+// the caller falls back to reading it through the REST API, which also reports why.
+func (b *repositoryBatcher) get(ctx context.Context, owner, name string) *batchedRepository {
+	req := &repositoryBatchRequest{owner: owner, name: name, done: make(chan struct{})}
+
+	b.mu.Lock()
+	b.pending = append(b.pending, req)
+	switch {
+	case len(b.pending) >= b.size:
+		go b.run(b.take())
+	case len(b.pending) == 1:
+		b.timer = time.AfterFunc(repositoryBatchWindow, b.flush)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-req.done:
+		return req.repository
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+// take returns the pending requests as a batch. The caller must hold the lock.
+func (b *repositoryBatcher) take() []*repositoryBatchRequest {
+	if b.timer != nil {
+		b.timer.Stop()
+		b.timer = nil
+	}
+	batch := b.pending
+	b.pending = nil
+	return batch
+}
+
+func (b *repositoryBatcher) flush() {
+	b.mu.Lock()
+	batch := b.take()
+	b.mu.Unlock()
+
+	if len(batch) > 0 {
+		b.run(batch)
+	}
+}
+
+// run reads a batch of repositories with a single query, in which repository i is aliased as r<i>. The query
+// is run with the provider context rather than the one of a reader, so one cancelled read doesn't fail the others.
+func (b *repositoryBatcher) run(batch []*repositoryBatchRequest) {
+	defer func() {
+		for _, req := range batch {
+			close(req.done)
+		}
+	}()
+
+	fields := make([]reflect.StructField, len(batch))
+	variables := make(map[string]any, 2*len(batch))
+	for i, req := range batch {
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("R%d", i),
+			Type: reflect.TypeFor[*batchedRepository](),
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"r%[1]d: repository(owner: $owner%[1]d, name: $name%[1]d)"`, i)),
+		}
+		variables[fmt.Sprintf("owner%d", i)] = githubv4.String(req.owner)
+		variables[fmt.Sprintf("name%d", i)] = githubv4.String(req.name)
+	}
+
+	query := reflect.New(reflect.StructOf(fields))
+	// Repositories which couldn't be read, for example as they no longer exist, are returned as null alongside an
+	// error while the others are still decoded.
+	if err := b.client.Query(b.ctx, query.Interface(), variables); err != nil {
+		log.Printf("[DEBUG] Batched read of %d repositories returned an error: %s", len(batch), err)
+	}
+
+	for i, req := range batch {
+		if repo, ok := query.Elem().Field(i).Interface().(*batchedRepository); ok && repo != nil && repo.ID != "" {
+			req.repository = repo
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/shurcooL/githubv4"
+)
+
+// newRepositoryBatchMux returns a GraphQL handler answering aliased repository queries, and the number of queries
+// and the last query it received. Repositories named missing are reported as not found, and the viewer can only read
+// repositories named read-only.
+func newRepositoryBatchMux() (*http.ServeMux, func() (int, string)) {
+	var mu sync.Mutex
+	var queries int
+	var lastQuery string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		mu.Lock()
+		queries++
+		lastQuery = body.Query
+		mu.Unlock()
+
+		data := make(map[string]any)
+		var errors []any
+		for i := 0; body.Variables[fmt.Sprintf("name%d", i)] != nil; i++ {
+			owner, name := body.Variables[fmt.Sprintf("owner%d", i)], body.Variables[fmt.Sprintf("name%d", i)]
+			alias := fmt.Sprintf("r%d", i)
+			if name == "missing" {
+				data[alias] = nil
+				errors = append(errors, map[string]any{
+					"type":    "NOT_FOUND",
+					"path":    []any{alias},
+					"message": fmt.Sprintf("Could not resolve to a Repository with the name '%s/%s'.", owner, name),
+				})
+				continue
+			}
+			permission := "ADMIN"
+			if name == "read-only" {
+				permission = "READ"
+			}
+			data[alias] = map[string]any{
+				"id":                            fmt.Sprintf("R_%s", name),
+				"databaseId":                    100 + i,
+				"name":                          name,
+				"nameWithOwner":                 fmt.Sprintf("%s/%s", owner, name),
+				"url":                           fmt.Sprintf("https://github.com/%s/%s", owner, name),
+				"sshUrl":                        fmt.Sprintf("git@github.com:%s/%s.git", owner, name),
+				"visibility":                    "PRIVATE",
+				"isPrivate":                     true,
+				"hasIssuesEnabled":              true,
+				"squashMergeCommitTitle":        "COMMIT_OR_PR_TITLE",
+				"hasVulnerabilityAlertsEnabled": true,
+				"viewerPermission":              permission,
+				"defaultBranchRef":              map[string]any{"name": "main"},
+				"primaryLanguage":               nil,
+				"parent":                        nil,
+				"templateRepository":            map[string]any{"name": "template", "owner": map[string]any{"login": "octocat"}},
+				"repositoryTopics": map[string]any{"nodes": []any{
+					map[string]any{"topic": map[string]any{"name": "terraform"}},
+				}},
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(map[string]any{"data": data, "errors": errors}); err != nil {
+			panic(err)
+		}
+	})
+
+	return mux, func() (int, string) {
+		mu.Lock()
+		defer mu.Unlock()
+		return queries, lastQuery
+	}
+}
+
+func TestRepositoryBatcher(t *testing.T) {
+	t.Run("reads concurrent repositories with a single query", func(t *testing.T) {
+		mux, queries := newRepositoryBatchMux()
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+		batcher := newRepositoryBatcher(t.Context(), client)
+
+		names := []string{"one", "two", "missing"}
+		results := make([]*batchedRepository, len(names))
+		var wg sync.WaitGroup
+		for i, name := range names {
+			wg.Go(func() {
+				results[i] = batcher.get(t.Context(), "test-owner", name)
+			})
+		}
+		wg.Wait()
+
+		count, query := queries()
+		if count != 1 {
+			t.Fatalf("got %d queries; want 1", count)
+		}
+		for i := range names {
+			if !strings.Contains(query, fmt.Sprintf("r%[1]d: repository(owner: $owner%[1]d, name: $name%[1]d)", i)) {
+				t.Errorf("expected query to alias repository %d, got: %s", i, query)
+			}
+		}
+
+		for i, name := range names[:2] {
+			if results[i] == nil {
+				t.Fatalf("expected repository %s to be read", name)
+			}
+			if got := results[i].NameWithOwner; got != "test-owner/"+name {
+				t.Errorf("got repository %q; want %q", got, "test-owner/"+name)
+			}
+		}
+		if results[2] != nil {
+			t.Errorf("expected the missing repository not to be read, got %v", results[2])
+		}
+	})
+
+	t.Run("splits reads into batches of the maximum size", func(t *testing.T) {
+		mux, queries := newRepositoryBatchMux()
+		client := githubv4.NewClient(&http.Client{Transport: localRoundTripper{handler: mux}})
+		batcher := newRepositoryBatcher(t.Context(), client)
+		batcher.size = 2
+
+		var wg sync.WaitGroup
+		for i := range 5 {
+			wg.Go(func() {
+				if repo := batcher.get(t.Context(), "test-owner", fmt.Sprintf("repo-%d", i)); repo == nil {
+					t.Errorf("expected repository %d to be read", i)
+				}
+			})
+		}
+		wg.Wait()
+
+		if count, _ := queries(); count != 3 {
+			t.Fatalf("got %d queries; want 3", count)
+		}
+	})
+}
+
+func TestResourceGithubRepositoryReadBatched(t *testing.T) {
+	mux, _ := newRepositoryBatchMux()
+	meta := newTestOwner(t, mux, "test-owner", true)
+	meta.repositoryBatcher = newRepositoryBatcher(t.Context(), meta.v4client)
+
+	d := schema.TestResourceDataRaw(t, resourceGithubRepository().Schema, map[string]any{
+		"name": "one",
+	})
+	d.SetId("one")
+
+	if diags := resourceGithubRepositoryRead(t.Context(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for k, want := range map[string]any{
+		"full_name":                 "test-owner/one",
+		"repo_id":                   100,
+		"node_id":                   "R_one",
+		"visibility":                "private",
+		"private":                   true,
+		"has_issues":                true,
+		"default_branch":            "main",
+		"git_clone_url":             "git://github.com/test-owner/one.git",
+		"http_clone_url":            "https://github.com/test-owner/one.git",
+		"squash_merge_commit_title": "COMMIT_OR_PR_TITLE",
+		"vulnerability_alerts":      true,
+		"fork":                      "false",
+		"template.0.repository":     "template",
+	} {
+		if got := d.Get(k); got != want {
+			t.Errorf("got %s %v; want %v", k, got, want)
+		}
+	}
+	if topics := d.Get("topics").(*schema.Set); topics.Len() != 1 || !topics.Contains("terraform") {
+		t.Errorf("got topics %v; want [terraform]", topics.List())
+	}
+}
+
+func TestResourceGithubRepositoryReadBatchedUnservedSettings(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		want []string
+		skip []string
+	}{
+		{name: "one", want: []string{"vulnerability_alerts"}, skip: []string{"has_downloads", "pages.#"}},
+		{name: "read-only", skip: []string{"vulnerability_alerts", "has_downloads", "pages.#"}},
+	} {
+		t.Run(fmt.Sprintf("leaves settings GraphQL doesn't serve to %s out of state", tc.name), func(t *testing.T) {
+			mux, _ := newRepositoryBatchMux()
+			meta := newTestOwner(t, mux, "test-owner", true)
+			meta.repositoryBatcher = newRepositoryBatcher(t.Context(), meta.v4client)
+
+			d := schema.TestResourceDataRaw(t, resourceGithubRepository().Schema, map[string]any{"name": tc.name})
+			d.SetId(tc.name)
+
+			if diags := resourceGithubRepositoryRead(t.Context(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			attributes := d.State().Attributes
+			for _, k := range tc.want {
+				if _, ok := attributes[k]; !ok {
+					t.Errorf("expected %s to be read", k)
+				}
+			}
+			for _, k := range tc.skip {
+				if v, ok := attributes[k]; ok {
+					t.Errorf("expected %s to be left out of state, got: %s", k, v)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceGithubRepositoryReadBatchedFallback(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		state map[string]any
+	}{
+		{name: "security_and_analysis", state: map[string]any{"security_and_analysis": []any{map[string]any{
+			"secret_scanning": []any{map[string]any{"status": "disabled"}},
+		}}}},
+		{name: "has_downloads", state: map[string]any{"has_downloads": true}},
+		{name: "pages", state: map[string]any{"pages": []any{map[string]any{"build_type": "workflow"}}}},
+	} {
+		t.Run(fmt.Sprintf("reads repositories tracking %s through the REST API", tc.name), func(t *testing.T) {
+			mux, queries := newRepositoryBatchMux()
+			mux.HandleFunc("GET /repos/test-owner/one", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				mustWrite(w, `{
+					"name": "one",
+					"full_name": "test-owner/one",
+					"has_downloads": false,
+					"security_and_analysis": {"secret_scanning": {"status": "enabled"}}
+				}`)
+			})
+			mux.HandleFunc("GET /repos/test-owner/one/vulnerability-alerts", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			})
+			meta := newTestOwner(t, mux, "test-owner", true)
+			meta.repositoryBatcher = newRepositoryBatcher(t.Context(), meta.v4client)
+
+			state := map[string]any{"name": "one"}
+			maps.Copy(state, tc.state)
+			d := schema.TestResourceDataRaw(t, resourceGithubRepository().Schema, state)
+			d.SetId("one")
+
+			if diags := resourceGithubRepositoryRead(t.Context(), d, meta); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if count, _ := queries(); count != 0 {
+				t.Errorf("got %d GraphQL queries; want none", count)
+			}
+			if d.Get("has_downloads").(bool) {
+				t.Error("got has_downloads true; want it refreshed to false")
+			}
+			if got := d.Get("security_and_analysis.0.secret_scanning.0.status"); got != "enabled" {
+				t.Errorf("got secret scanning %v; want it refreshed to enabled", got)
+			}
+		})
+	}
+}